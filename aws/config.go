@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/appsync"
@@ -29,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
@@ -41,6 +43,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
@@ -63,13 +66,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
 	"github.com/aws/aws-sdk-go/service/kms"
@@ -78,6 +84,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/aws/aws-sdk-go/service/mq"
@@ -86,14 +94,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
@@ -132,6 +144,10 @@ type Config struct {
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
+	DefaultTags           map[string]interface{}
+	IgnoreTagsKeys        []string
+	IgnoreTagsKeyPrefixes []string
+
 	AcmEndpoint              string
 	ApigatewayEndpoint       string
 	CloudFormationEndpoint   string
@@ -175,6 +191,7 @@ type AWSClient struct {
 	cloud9conn            *cloud9.Cloud9
 	cloudfrontconn        *cloudfront.CloudFront
 	cloudhsmv2conn        *cloudhsmv2.CloudHSMV2
+	cloudsearchconn       *cloudsearch.CloudSearch
 	cloudtrailconn        *cloudtrail.CloudTrail
 	cloudwatchconn        *cloudwatch.CloudWatch
 	cloudwatchlogsconn    *cloudwatchlogs.CloudWatchLogs
@@ -201,12 +218,15 @@ type AWSClient struct {
 	acmconn               *acm.ACM
 	acmpcaconn            *acmpca.ACMPCA
 	apigateway            *apigateway.APIGateway
+	apigatewayv2conn      *apigatewayv2.ApiGatewayV2
 	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
 	autoscalingconn       *autoscaling.AutoScaling
 	s3conn                *s3.S3
 	s3controlconn         *s3control.S3Control
+	sagemakerconn         *sagemaker.SageMaker
 	secretsmanagerconn    *secretsmanager.SecretsManager
 	securityhubconn       *securityhub.SecurityHub
+	serverlessrepoconn    *serverlessapplicationrepository.ServerlessApplicationRepository
 	scconn                *servicecatalog.ServiceCatalog
 	sesConn               *ses.SES
 	simpledbconn          *simpledb.SimpleDB
@@ -216,18 +236,22 @@ type AWSClient struct {
 	redshiftconn          *redshift.Redshift
 	resourcegroupsconn    *resourcegroups.ResourceGroups
 	r53conn               *route53.Route53
+	ramconn               *ram.RAM
+	route53resolverconn   *route53resolver.Route53Resolver
 	partition             string
 	accountid             string
 	supportedplatforms    []string
 	region                string
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
+	kafkaconn             *kafka.Kafka
 	kinesisconn           *kinesis.Kinesis
 	kinesisanalyticsconn  *kinesisanalytics.KinesisAnalytics
 	kmsconn               *kms.KMS
 	gameliftconn          *gamelift.GameLift
 	firehoseconn          *firehose.Firehose
 	fmsconn               *fms.FMS
+	fsxconn               *fsx.FSx
 	inspectorconn         *inspector.Inspector
 	elasticacheconn       *elasticache.ElastiCache
 	elasticbeanstalkconn  *elasticbeanstalk.ElasticBeanstalk
@@ -240,6 +264,7 @@ type AWSClient struct {
 	opsworksconn          *opsworks.OpsWorks
 	organizationsconn     *organizations.Organizations
 	glacierconn           *glacier.Glacier
+	globalacceleratorconn *globalaccelerator.GlobalAccelerator
 	guarddutyconn         *guardduty.GuardDuty
 	codebuildconn         *codebuild.CodeBuild
 	codedeployconn        *codedeploy.CodeDeploy
@@ -257,6 +282,9 @@ type AWSClient struct {
 	glueconn              *glue.Glue
 	athenaconn            *athena.Athena
 	dxconn                *directconnect.DirectConnect
+	mediaconvertconn      *mediaconvert.MediaConvert
+	mediaconvertacctconn  *mediaconvert.MediaConvert
+	medialiveconn         *medialive.MediaLive
 	mediapackageconn      *mediapackage.MediaPackage
 	mediastoreconn        *mediastore.MediaStore
 	appsyncconn           *appsync.AppSync
@@ -269,6 +297,10 @@ type AWSClient struct {
 	appmeshconn           *appmesh.AppMesh
 	transferconn          *transfer.Transfer
 	docdbconn             *docdb.DocDB
+	datapipelineconn      *datapipeline.DataPipeline
+	defaultTags           map[string]interface{}
+	ignoreTagsKeys        []string
+	ignoreTagsKeyPrefixes []string
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -303,6 +335,12 @@ func (c *Config) Client() (interface{}, error) {
 	// bucket storage in S3
 	client.region = c.Region
 
+	// store the provider default and ignored tags, applied to the tags of
+	// every resource supporting them
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagsKeys = c.IgnoreTagsKeys
+	client.ignoreTagsKeyPrefixes = c.IgnoreTagsKeyPrefixes
+
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
 	if err != nil {
@@ -522,12 +560,14 @@ func (c *Config) Client() (interface{}, error) {
 	client.acmconn = acm.New(awsAcmSess)
 	client.acmpcaconn = acmpca.New(sess)
 	client.apigateway = apigateway.New(awsApigatewaySess)
+	client.apigatewayv2conn = apigatewayv2.New(awsApigatewaySess)
 	client.appautoscalingconn = applicationautoscaling.New(sess)
 	client.autoscalingconn = autoscaling.New(awsAutoscalingSess)
 	client.cloud9conn = cloud9.New(sess)
 	client.cfconn = cloudformation.New(awsCfSess)
 	client.cloudfrontconn = cloudfront.New(sess)
 	client.cloudhsmv2conn = cloudhsmv2.New(sess)
+	client.cloudsearchconn = cloudsearch.New(sess)
 	client.cloudtrailconn = cloudtrail.New(sess)
 	client.cloudwatchconn = cloudwatch.New(awsCwSess)
 	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
@@ -558,11 +598,14 @@ func (c *Config) Client() (interface{}, error) {
 	client.esconn = elasticsearch.New(awsEsSess)
 	client.firehoseconn = firehose.New(sess)
 	client.fmsconn = fms.New(sess)
+	client.fsxconn = fsx.New(sess)
 	client.inspectorconn = inspector.New(sess)
 	client.gameliftconn = gamelift.New(sess)
 	client.glacierconn = glacier.New(sess)
+	client.globalacceleratorconn = globalaccelerator.New(sess)
 	client.guarddutyconn = guardduty.New(sess)
 	client.iotconn = iot.New(sess)
+	client.kafkaconn = kafka.New(sess)
 	client.kinesisconn = kinesis.New(awsKinesisSess)
 	client.kinesisanalyticsconn = kinesisanalytics.New(awsKinesisAnalyticsSess)
 	client.kmsconn = kms.New(awsKmsSess)
@@ -576,12 +619,16 @@ func (c *Config) Client() (interface{}, error) {
 	client.opsworksconn = opsworks.New(sess)
 	client.organizationsconn = organizations.New(sess)
 	client.r53conn = route53.New(r53Sess)
+	client.ramconn = ram.New(sess)
 	client.rdsconn = rds.New(awsRdsSess)
 	client.redshiftconn = redshift.New(sess)
 	client.resourcegroupsconn = resourcegroups.New(sess)
+	client.route53resolverconn = route53resolver.New(sess)
 	client.simpledbconn = simpledb.New(sess)
 	client.s3conn = s3.New(awsS3Sess)
 	client.s3controlconn = s3control.New(awsS3ControlSess)
+	client.sagemakerconn = sagemaker.New(sess)
+	client.serverlessrepoconn = serverlessapplicationrepository.New(sess)
 	client.scconn = servicecatalog.New(sess)
 	client.sdconn = servicediscovery.New(sess)
 	client.sesConn = ses.New(sess)
@@ -599,6 +646,8 @@ func (c *Config) Client() (interface{}, error) {
 	client.glueconn = glue.New(sess)
 	client.athenaconn = athena.New(sess)
 	client.dxconn = directconnect.New(sess)
+	client.mediaconvertconn = mediaconvert.New(sess)
+	client.medialiveconn = medialive.New(sess)
 	client.mediapackageconn = mediapackage.New(sess)
 	client.mediastoreconn = mediastore.New(sess)
 	client.appsyncconn = appsync.New(sess)
@@ -609,6 +658,7 @@ func (c *Config) Client() (interface{}, error) {
 	client.appmeshconn = appmesh.New(sess)
 	client.transferconn = transfer.New(sess)
 	client.docdbconn = docdb.New(sess)
+	client.datapipelineconn = datapipeline.New(sess)
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -0,0 +1,38 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsEc2ClientVpnClientConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	dataSourceName := "data.aws_ec2_client_vpn_client_configuration.test"
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2ClientVpnClientConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_vpn_endpoint_id", resourceName, "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "client_configuration", regexp.MustCompile(`(?m)^client$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "client_configuration", regexp.MustCompile(`(?m)^remote \S+ 443$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEc2ClientVpnClientConfigurationConfig(rInt int) string {
+	return testAccAWSEc2ClientVpnEndpointConfig(rInt) + `
+data "aws_ec2_client_vpn_client_configuration" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+}
+`
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsMskCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsMskClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kafka_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_broker_nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"zookeeper_connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+	name := d.Get("cluster_name").(string)
+
+	input := &kafka.ListClustersInput{
+		ClusterNameFilter: aws.String(name),
+	}
+
+	var clusters []*kafka.ClusterInfo
+
+	log.Printf("[DEBUG] Reading MSK Clusters: %s", input)
+	for {
+		output, err := conn.ListClusters(input)
+
+		if err != nil {
+			return fmt.Errorf("error listing MSK Clusters: %s", err)
+		}
+
+		// The name filter matches prefixes, only keep exact matches.
+		for _, cluster := range output.ClusterInfoList {
+			if aws.StringValue(cluster.ClusterName) == name {
+				clusters = append(clusters, cluster)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if len(clusters) == 0 {
+		return fmt.Errorf("error reading MSK Cluster (%s): no results found", name)
+	}
+
+	if len(clusters) > 1 {
+		return fmt.Errorf("error reading MSK Cluster (%s): multiple results found, try adjusting search criteria", name)
+	}
+
+	cluster := clusters[0]
+
+	brokerOutput, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: cluster.ClusterArn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s) bootstrap brokers: %s", aws.StringValue(cluster.ClusterArn), err)
+	}
+
+	d.SetId(aws.StringValue(cluster.ClusterArn))
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("bootstrap_brokers", brokerOutput.BootstrapBrokerString)
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("current_version", cluster.CurrentVersion)
+
+	if cluster.CurrentBrokerSoftwareInfo != nil {
+		d.Set("kafka_version", cluster.CurrentBrokerSoftwareInfo.KafkaVersion)
+	}
+
+	d.Set("number_of_broker_nodes", cluster.NumberOfBrokerNodes)
+	d.Set("zookeeper_connect_string", cluster.ZookeeperConnectString)
+
+	return nil
+}
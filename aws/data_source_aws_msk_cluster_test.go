@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSMskClusterDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	dataSourceResourceName := "data.aws_msk_cluster.test"
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMskClusterDataSourceConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "bootstrap_brokers", dataSourceResourceName, "bootstrap_brokers"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", dataSourceResourceName, "cluster_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "current_version", dataSourceResourceName, "current_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "kafka_version", dataSourceResourceName, "kafka_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "number_of_broker_nodes", dataSourceResourceName, "number_of_broker_nodes"),
+					resource.TestCheckResourceAttrPair(resourceName, "zookeeper_connect_string", dataSourceResourceName, "zookeeper_connect_string"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSMskClusterDataSourceConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_msk_cluster" "test" {
+  cluster_name = "${aws_msk_cluster.test.cluster_name}"
+}
+`, testAccAWSMskClusterConfig_Required(rName))
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsAccounts_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_organizations_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsAccountsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accounts.0.id", "aws_organizations_organization.test", "master_account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accounts.0.arn", "aws_organizations_organization.test", "master_account_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accounts.0.email", "aws_organizations_organization.test", "master_account_email"),
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.aws_organizations_accounts.empty", "accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsAccountsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %q
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_accounts" "test" {
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_accounts" "empty" {
+  parent_id = "${aws_organizations_organizational_unit.test.id}"
+}
+`, name)
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsServerlessRepositoryApplication() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsServerlessRepositoryApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"required_capabilities": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"source_code_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsServerlessRepositoryApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).serverlessrepoconn
+
+	applicationId := d.Get("application_id").(string)
+
+	input := &serverlessapplicationrepository.GetApplicationInput{
+		ApplicationId: aws.String(applicationId),
+	}
+
+	if v, ok := d.GetOk("semantic_version"); ok {
+		input.SemanticVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.GetApplication(input)
+	if err != nil {
+		return fmt.Errorf("error getting Serverless Application Repository application (%s): %s", applicationId, err)
+	}
+
+	if output.Version == nil {
+		return fmt.Errorf("error getting Serverless Application Repository application (%s): empty version", applicationId)
+	}
+
+	d.SetId(applicationId)
+	d.Set("name", output.Name)
+	d.Set("semantic_version", output.Version.SemanticVersion)
+	d.Set("source_code_url", output.Version.SourceCodeUrl)
+	d.Set("template_url", output.Version.TemplateUrl)
+
+	if err := d.Set("required_capabilities", flattenStringSet(output.Version.RequiredCapabilities)); err != nil {
+		return fmt.Errorf("error setting required_capabilities: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsServerlessRepositoryApplication_basic(t *testing.T) {
+	datasourceName := "data.aws_serverlessapplicationrepository_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsServerlessRepositoryApplicationDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "application_id", testAccAwsServerlessRepositoryApplicationId),
+					resource.TestCheckResourceAttr(datasourceName, "name", "SecretsManagerRDSPostgreSQLRotationSingleUser"),
+					resource.TestCheckResourceAttrSet(datasourceName, "semantic_version"),
+					resource.TestCheckResourceAttrSet(datasourceName, "source_code_url"),
+					resource.TestCheckResourceAttrSet(datasourceName, "template_url"),
+					resource.TestCheckResourceAttr(datasourceName, "required_capabilities.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckAwsServerlessRepositoryApplicationDataSourceConfigNonExistent(),
+				ExpectError: regexp.MustCompile(`error getting Serverless Application Repository application`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsServerlessRepositoryApplication_versioned(t *testing.T) {
+	datasourceName := "data.aws_serverlessapplicationrepository_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsServerlessRepositoryApplicationDataSourceConfigVersioned("1.0.13"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "semantic_version", "1.0.13"),
+					resource.TestCheckResourceAttrSet(datasourceName, "template_url"),
+					resource.TestCheckResourceAttr(datasourceName, "required_capabilities.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckAwsServerlessRepositoryApplicationDataSourceConfigVersioned("1.1.36"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "semantic_version", "1.1.36"),
+					resource.TestCheckResourceAttrSet(datasourceName, "template_url"),
+					resource.TestCheckResourceAttr(datasourceName, "required_capabilities.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServerlessRepositoryApplicationDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "aws_serverlessapplicationrepository_application" "test" {
+  application_id = %[1]q
+}
+`, testAccAwsServerlessRepositoryApplicationId)
+}
+
+func testAccCheckAwsServerlessRepositoryApplicationDataSourceConfigNonExistent() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_serverlessapplicationrepository_application" "no_such_function" {
+  application_id = "arn:aws:serverlessrepo:us-east-1:${data.aws_caller_identity.current.account_id}:applications/ThisFunctionDoesNotExist"
+}
+`
+}
+
+func testAccCheckAwsServerlessRepositoryApplicationDataSourceConfigVersioned(version string) string {
+	return fmt.Sprintf(`
+data "aws_serverlessapplicationrepository_application" "test" {
+  application_id   = %[1]q
+  semantic_version = %[2]q
+}
+`, testAccAwsServerlessRepositoryApplicationId, version)
+}
@@ -3,29 +3,16 @@ package aws
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // dataSyncTagsDiff takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func dataSyncTagsDiff(oldTags, newTags []*datasync.TagListEntry) ([]*datasync.TagListEntry, []*datasync.TagListEntry) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*datasync.TagListEntry
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsDataSync(oldTags), keyValueTagsDataSync(newTags)
 
-	return expandDataSyncTagListEntry(create), remove
+	return tagsFromKeyValueTagsDataSync(o.Updated(n)), tagsFromKeyValueTagsDataSync(o.Removed(n))
 }
 
 func dataSyncTagsKeys(tags []*datasync.TagListEntry) []*string {
@@ -42,21 +29,34 @@ func dataSyncTagsKeys(tags []*datasync.TagListEntry) []*string {
 }
 
 func expandDataSyncTagListEntry(m map[string]interface{}) []*datasync.TagListEntry {
-	result := []*datasync.TagListEntry{}
-	for k, v := range m {
-		result = append(result, &datasync.TagListEntry{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return tagsFromKeyValueTagsDataSync(keyvaluetags.New(m).IgnoreAws())
 }
 
 func flattenDataSyncTagListEntry(ts []*datasync.TagListEntry) map[string]string {
-	result := map[string]string{}
+	return keyValueTagsDataSync(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsDataSync returns the tags engine representation of the tags.
+func keyValueTagsDataSync(ts []*datasync.TagListEntry) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		if t == nil {
+			continue
+		}
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsDataSync returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDataSync(tags keyvaluetags.KeyValueTags) []*datasync.TagListEntry {
+	result := make([]*datasync.TagListEntry, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &datasync.TagListEntry{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
 	return result
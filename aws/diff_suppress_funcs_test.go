@@ -70,3 +70,49 @@ func TestSuppressEquivalentTypeStringBoolean(t *testing.T) {
 		}
 	}
 }
+
+func TestSuppressEquivalentRFC3339Time(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2018-03-01T00:00:00Z",
+			new:        "2018-03-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2018-03-01T00:00:00Z",
+			new:        "2018-03-01T02:00:00+02:00",
+			equivalent: true,
+		},
+		{
+			old:        "2018-03-01T00:00:00Z",
+			new:        "2018-03-01T00:00:00+02:00",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2018-03-01T00:00:00Z",
+			equivalent: false,
+		},
+		{
+			old:        "2018-03-01T00:00:00Z",
+			new:        "2018-03-01",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := suppressEquivalentRFC3339Time("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
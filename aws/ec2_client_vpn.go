@@ -0,0 +1,417 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func decodeEc2ClientVpnNetworkAssociationImportID(id string) (string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected cvpn-endpoint-ID_cvpn-assoc-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func decodeEc2ClientVpnAuthorizationRuleID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected cvpn-endpoint-ID_TARGET-NETWORK-CIDR_ACCESS-GROUP-ID (ACCESS-GROUP-ID may be empty)", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func decodeEc2ClientVpnRouteID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected cvpn-endpoint-ID_subnet-ID_DESTINATION", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func ec2DescribeClientVpnEndpoint(conn *ec2.EC2, clientVpnEndpointID string) (*ec2.ClientVpnEndpoint, error) {
+	input := &ec2.DescribeClientVpnEndpointsInput{
+		ClientVpnEndpointIds: []*string{aws.String(clientVpnEndpointID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Client VPN Endpoint (%s): %s", clientVpnEndpointID, input)
+	output, err := conn.DescribeClientVpnEndpoints(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, clientVpnEndpoint := range output.ClientVpnEndpoints {
+		if clientVpnEndpoint == nil {
+			continue
+		}
+
+		if aws.StringValue(clientVpnEndpoint.ClientVpnEndpointId) == clientVpnEndpointID {
+			return clientVpnEndpoint, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeClientVpnNetworkAssociation(conn *ec2.EC2, clientVpnEndpointID, associationID string) (*ec2.TargetNetwork, error) {
+	input := &ec2.DescribeClientVpnTargetNetworksInput{
+		AssociationIds:      []*string{aws.String(associationID)},
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Client VPN Network Association (%s): %s", associationID, input)
+	output, err := conn.DescribeClientVpnTargetNetworks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, targetNetwork := range output.ClientVpnTargetNetworks {
+		if targetNetwork == nil {
+			continue
+		}
+
+		if aws.StringValue(targetNetwork.AssociationId) == associationID {
+			return targetNetwork, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeClientVpnAuthorizationRule(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string) (*ec2.AuthorizationRule, error) {
+	input := &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("destination-cidr"),
+				Values: []*string{aws.String(targetNetworkCidr)},
+			},
+		},
+	}
+
+	for {
+		log.Printf("[DEBUG] Reading EC2 Client VPN Authorization Rules (%s): %s", clientVpnEndpointID, input)
+		output, err := conn.DescribeClientVpnAuthorizationRules(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, nil
+		}
+
+		for _, rule := range output.AuthorizationRules {
+			if rule == nil {
+				continue
+			}
+
+			if aws.StringValue(rule.DestinationCidr) != targetNetworkCidr {
+				continue
+			}
+
+			// Rules authorizing all groups have no group ID
+			if accessGroupID == "" && aws.BoolValue(rule.AccessAll) {
+				return rule, nil
+			}
+
+			if accessGroupID != "" && aws.StringValue(rule.GroupId) == accessGroupID {
+				return rule, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeClientVpnRoute(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destination string) (*ec2.ClientVpnRoute, error) {
+	input := &ec2.DescribeClientVpnRoutesInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("destination-cidr"),
+				Values: []*string{aws.String(destination)},
+			},
+			{
+				Name:   aws.String("target-subnet"),
+				Values: []*string{aws.String(targetSubnetID)},
+			},
+		},
+	}
+
+	for {
+		log.Printf("[DEBUG] Reading EC2 Client VPN Routes (%s): %s", clientVpnEndpointID, input)
+		output, err := conn.DescribeClientVpnRoutes(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, nil
+		}
+
+		for _, route := range output.Routes {
+			if route == nil {
+				continue
+			}
+
+			if aws.StringValue(route.DestinationCidr) == destination && aws.StringValue(route.TargetSubnet) == targetSubnetID {
+				return route, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2ClientVpnEndpointRefreshFunc(conn *ec2.EC2, clientVpnEndpointID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		clientVpnEndpoint, err := ec2DescribeClientVpnEndpoint(conn, clientVpnEndpointID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, ec2.ClientVpnEndpointStatusCodeDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Client VPN Endpoint (%s): %s", clientVpnEndpointID, err)
+		}
+
+		if clientVpnEndpoint == nil || clientVpnEndpoint.Status == nil {
+			return nil, ec2.ClientVpnEndpointStatusCodeDeleted, nil
+		}
+
+		return clientVpnEndpoint, aws.StringValue(clientVpnEndpoint.Status.Code), nil
+	}
+}
+
+func ec2ClientVpnNetworkAssociationRefreshFunc(conn *ec2.EC2, clientVpnEndpointID, associationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		targetNetwork, err := ec2DescribeClientVpnNetworkAssociation(conn, clientVpnEndpointID, associationID)
+
+		if isAWSErr(err, "InvalidClientVpnAssociationId.NotFound", "") || isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, ec2.AssociationStatusCodeDisassociated, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Client VPN Network Association (%s): %s", associationID, err)
+		}
+
+		if targetNetwork == nil || targetNetwork.Status == nil {
+			return nil, ec2.AssociationStatusCodeDisassociated, nil
+		}
+
+		if aws.StringValue(targetNetwork.Status.Code) == ec2.AssociationStatusCodeAssociationFailed {
+			return targetNetwork, ec2.AssociationStatusCodeAssociationFailed, fmt.Errorf("association failed: %s", aws.StringValue(targetNetwork.Status.Message))
+		}
+
+		return targetNetwork, aws.StringValue(targetNetwork.Status.Code), nil
+	}
+}
+
+func ec2ClientVpnAuthorizationRuleRefreshFunc(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Client VPN Authorization Rule: %s", err)
+		}
+
+		if rule == nil || rule.Status == nil {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(rule.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeFailed {
+			return rule, ec2.ClientVpnAuthorizationRuleStatusCodeFailed, fmt.Errorf("authorization failed: %s", aws.StringValue(rule.Status.Message))
+		}
+
+		return rule, aws.StringValue(rule.Status.Code), nil
+	}
+}
+
+func ec2ClientVpnRouteRefreshFunc(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destination string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destination)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Client VPN Route: %s", err)
+		}
+
+		if route == nil || route.Status == nil {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(route.Status.Code) == ec2.ClientVpnRouteStatusCodeFailed {
+			return route, ec2.ClientVpnRouteStatusCodeFailed, fmt.Errorf("route creation failed: %s", aws.StringValue(route.Status.Message))
+		}
+
+		return route, aws.StringValue(route.Status.Code), nil
+	}
+}
+
+func waitForEc2ClientVpnEndpointDeletion(conn *ec2.EC2, clientVpnEndpointID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.ClientVpnEndpointStatusCodeAvailable,
+			ec2.ClientVpnEndpointStatusCodePendingAssociate,
+			ec2.ClientVpnEndpointStatusCodeDeleting,
+		},
+		Target:         []string{ec2.ClientVpnEndpointStatusCodeDeleted},
+		Refresh:        ec2ClientVpnEndpointRefreshFunc(conn, clientVpnEndpointID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Endpoint (%s) deletion", clientVpnEndpointID)
+	_, err := stateConf.WaitForState()
+
+	if isResourceNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func waitForEc2ClientVpnNetworkAssociationCreation(conn *ec2.EC2, clientVpnEndpointID, associationID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AssociationStatusCodeAssociating},
+		Target:  []string{ec2.AssociationStatusCodeAssociated},
+		Refresh: ec2ClientVpnNetworkAssociationRefreshFunc(conn, clientVpnEndpointID, associationID),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Network Association (%s) availability", associationID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnNetworkAssociationDeletion(conn *ec2.EC2, clientVpnEndpointID, associationID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.AssociationStatusCodeAssociated,
+			ec2.AssociationStatusCodeDisassociating,
+		},
+		Target:         []string{ec2.AssociationStatusCodeDisassociated},
+		Refresh:        ec2ClientVpnNetworkAssociationRefreshFunc(conn, clientVpnEndpointID, associationID),
+		Timeout:        timeout,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Network Association (%s) deletion", associationID)
+	_, err := stateConf.WaitForState()
+
+	if isResourceNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func waitForEc2ClientVpnAuthorizationRuleCreation(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeAuthorizing},
+		Target:  []string{ec2.ClientVpnAuthorizationRuleStatusCodeActive},
+		Refresh: ec2ClientVpnAuthorizationRuleRefreshFunc(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID),
+		Timeout: 10 * time.Minute,
+		// Handle EC2 eventual consistency
+		NotFoundChecks: 20,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Authorization Rule (%s, %s) availability", clientVpnEndpointID, targetNetworkCidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnAuthorizationRuleDeletion(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.ClientVpnAuthorizationRuleStatusCodeActive,
+			ec2.ClientVpnAuthorizationRuleStatusCodeRevoking,
+		},
+		Target:  []string{},
+		Refresh: ec2ClientVpnAuthorizationRuleRefreshFunc(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Authorization Rule (%s, %s) deletion", clientVpnEndpointID, targetNetworkCidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnRouteCreation(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destination string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeCreating},
+		Target:  []string{ec2.ClientVpnRouteStatusCodeActive},
+		Refresh: ec2ClientVpnRouteRefreshFunc(conn, clientVpnEndpointID, targetSubnetID, destination),
+		Timeout: 10 * time.Minute,
+		// Handle EC2 eventual consistency
+		NotFoundChecks: 20,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Route (%s, %s) availability", clientVpnEndpointID, destination)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnRouteDeletion(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destination string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.ClientVpnRouteStatusCodeActive,
+			ec2.ClientVpnRouteStatusCodeDeleting,
+		},
+		Target:  []string{},
+		Refresh: ec2ClientVpnRouteRefreshFunc(conn, clientVpnEndpointID, targetSubnetID, destination),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Route (%s, %s) deletion", clientVpnEndpointID, destination)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,272 @@
+// Package keyvaluetags implements a generic engine for the key-value resource
+// tags used across AWS services.
+//
+// Each service only has to convert between its own tag type and KeyValueTags
+// and provide its list, tag and untag API calls as a Service, while the
+// computation of tag differences, the ignored tag handling, the ordering and
+// batching of tag updates and the retries on eventual consistency errors are
+// implemented once here.
+package keyvaluetags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AwsTagKeyPrefix is the prefix of the tag keys reserved for use by AWS.
+const AwsTagKeyPrefix = "aws:"
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// Tag values can be nil for services supporting tag keys without values.
+type KeyValueTags map[string]*string
+
+// New creates KeyValueTags from common Terraform and AWS SDK types.
+// Supported types are map[string]string, map[string]*string,
+// map[string]interface{}, []string and []interface{}; for the slice types
+// the elements are the tag keys and all values are nil.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case KeyValueTags:
+		tags := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			tags[k] = v
+		}
+
+		return tags
+	case map[string]string:
+		tags := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v
+			tags[k] = &str
+		}
+
+		return tags
+	case map[string]*string:
+		tags := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			if v == nil {
+				tags[k] = nil
+				continue
+			}
+
+			str := *v
+			tags[k] = &str
+		}
+
+		return tags
+	case map[string]interface{}:
+		tags := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v.(string)
+			tags[k] = &str
+		}
+
+		return tags
+	case []string:
+		tags := make(KeyValueTags, len(value))
+
+		for _, k := range value {
+			tags[k] = nil
+		}
+
+		return tags
+	case []interface{}:
+		tags := make(KeyValueTags, len(value))
+
+		for _, k := range value {
+			tags[k.(string)] = nil
+		}
+
+		return tags
+	case nil:
+		return make(KeyValueTags)
+	default:
+		panic(fmt.Sprintf("unsupported key-value tags type: %T", i))
+	}
+}
+
+// IsAwsKey returns whether the tag key is reserved for use by AWS.
+func IsAwsKey(k string) bool {
+	return strings.HasPrefix(k, AwsTagKeyPrefix)
+}
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !IsAwsKey(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Ignore returns the tags without the given tag keys.
+func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := ignoreTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnorePrefixes returns the tags whose keys do not start with any of the
+// given prefixes.
+func (tags KeyValueTags) IgnorePrefixes(prefixes []string) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !hasAnyPrefix(k, prefixes) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns the tag keys in lexical order.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Map returns the tags as a map of strings. Nil values become empty strings.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if v == nil {
+			result[k] = ""
+			continue
+		}
+
+		result[k] = *v
+	}
+
+	return result
+}
+
+// Merge returns the tags with the given tags added, overriding the values of
+// any existing keys.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := New(tags)
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns the tags whose keys are missing from the new tags.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the new tags which are missing from, or have a different
+// value than, the tags.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || !equalValues(oldV, newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Equal returns whether the tags have the same keys and values.
+func (tags KeyValueTags) Equal(other KeyValueTags) bool {
+	if len(tags) != len(other) {
+		return false
+	}
+
+	for k, v := range tags {
+		otherV, ok := other[k]
+
+		if !ok || !equalValues(v, otherV) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Chunks splits the tags into groups of at most size tags, following the
+// lexical order of the tag keys. A size of zero or less returns all tags in a
+// single group. Empty tags return no groups.
+func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
+	var result []KeyValueTags
+
+	if len(tags) == 0 {
+		return result
+	}
+
+	if size <= 0 {
+		size = len(tags)
+	}
+
+	chunk := make(KeyValueTags)
+
+	for _, k := range tags.Keys() {
+		chunk[k] = tags[k]
+
+		if len(chunk) == size {
+			result = append(result, chunk)
+			chunk = make(KeyValueTags)
+		}
+	}
+
+	if len(chunk) > 0 {
+		result = append(result, chunk)
+	}
+
+	return result
+}
+
+// equalValues compares tag values, not pointers.
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
+
+func hasAnyPrefix(k string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
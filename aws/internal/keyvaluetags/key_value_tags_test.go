@@ -0,0 +1,276 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	v := "value1"
+
+	testCases := []struct {
+		name string
+		in   interface{}
+		want map[string]string
+	}{
+		{
+			name: "nil",
+			in:   nil,
+			want: map[string]string{},
+		},
+		{
+			name: "map string",
+			in:   map[string]string{"key1": "value1"},
+			want: map[string]string{"key1": "value1"},
+		},
+		{
+			name: "map string pointer",
+			in:   map[string]*string{"key1": &v, "key2": nil},
+			want: map[string]string{"key1": "value1", "key2": ""},
+		},
+		{
+			name: "map interface",
+			in:   map[string]interface{}{"key1": "value1"},
+			want: map[string]string{"key1": "value1"},
+		},
+		{
+			name: "string slice",
+			in:   []string{"key1", "key2"},
+			want: map[string]string{"key1": "", "key2": ""},
+		},
+		{
+			name: "interface slice",
+			in:   []interface{}{"key1"},
+			want: map[string]string{"key1": ""},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := New(testCase.in).Map()
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	tags := New(map[string]string{
+		"aws:cloudformation:logical-id": "foo",
+		"aws:foo:bar":                   "baz",
+		"awsfoo":                        "bar",
+		"key1":                          "value1",
+	})
+
+	got := tags.IgnoreAws().Map()
+	want := map[string]string{
+		"awsfoo": "bar",
+		"key1":   "value1",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+		"key3": "value3",
+	})
+
+	got := tags.Ignore(New([]string{"key1", "key3", "key4"})).Map()
+	want := map[string]string{
+		"key2": "value2",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
+	tags := New(map[string]string{
+		"team:name":   "value1",
+		"team:owner":  "value2",
+		"costcenter":  "value3",
+		"other:team:": "value4",
+	})
+
+	got := tags.IgnorePrefixes([]string{"team:", "cost"}).Map()
+	want := map[string]string{
+		"other:team:": "value4",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	tags := New(map[string]string{
+		"key3": "value3",
+		"key1": "value1",
+		"key2": "value2",
+	})
+
+	got := tags.Keys()
+	want := []string{"key1", "key2", "key3"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestKeyValueTagsMerge(t *testing.T) {
+	tags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+
+	got := tags.Merge(New(map[string]string{
+		"key2": "value2updated",
+		"key3": "value3",
+	})).Map()
+	want := map[string]string{
+		"key1": "value1",
+		"key2": "value2updated",
+		"key3": "value3",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if tags.Map()["key2"] != "value2" {
+		t.Errorf("original tags modified: %v", tags.Map())
+	}
+}
+
+func TestKeyValueTagsRemovedUpdated(t *testing.T) {
+	testCases := []struct {
+		name        string
+		oldTags     map[string]string
+		newTags     map[string]string
+		wantRemoved map[string]string
+		wantUpdated map[string]string
+	}{
+		{
+			name:        "add",
+			oldTags:     map[string]string{"key1": "value1"},
+			newTags:     map[string]string{"key1": "value1", "key2": "value2"},
+			wantRemoved: map[string]string{},
+			wantUpdated: map[string]string{"key2": "value2"},
+		},
+		{
+			name:        "modify",
+			oldTags:     map[string]string{"key1": "value1"},
+			newTags:     map[string]string{"key1": "value1updated"},
+			wantRemoved: map[string]string{},
+			wantUpdated: map[string]string{"key1": "value1updated"},
+		},
+		{
+			name:        "remove",
+			oldTags:     map[string]string{"key1": "value1", "key2": "value2"},
+			newTags:     map[string]string{"key1": "value1"},
+			wantRemoved: map[string]string{"key2": "value2"},
+			wantUpdated: map[string]string{},
+		},
+		{
+			name:        "unchanged",
+			oldTags:     map[string]string{"key1": "value1"},
+			newTags:     map[string]string{"key1": "value1"},
+			wantRemoved: map[string]string{},
+			wantUpdated: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Distinct maps ensure values are compared, not pointers.
+			oldTags := New(testCase.oldTags)
+			newTags := New(testCase.newTags)
+
+			if got := oldTags.Removed(newTags).Map(); !reflect.DeepEqual(got, testCase.wantRemoved) {
+				t.Errorf("removed: got %v, want %v", got, testCase.wantRemoved)
+			}
+
+			if got := oldTags.Updated(newTags).Map(); !reflect.DeepEqual(got, testCase.wantUpdated) {
+				t.Errorf("updated: got %v, want %v", got, testCase.wantUpdated)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsEqual(t *testing.T) {
+	tags := New(map[string]string{"key1": "value1"})
+
+	if !tags.Equal(New(map[string]string{"key1": "value1"})) {
+		t.Errorf("expected equal tags")
+	}
+
+	if tags.Equal(New(map[string]string{"key1": "value2"})) {
+		t.Errorf("expected different values to not be equal")
+	}
+
+	if tags.Equal(New([]string{"key1"})) {
+		t.Errorf("expected nil value to not be equal")
+	}
+
+	if tags.Equal(New(map[string]string{"key1": "value1", "key2": "value2"})) {
+		t.Errorf("expected additional key to not be equal")
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	tags := New(map[string]string{
+		"key5": "value5",
+		"key1": "value1",
+		"key3": "value3",
+		"key2": "value2",
+		"key4": "value4",
+	})
+
+	testCases := []struct {
+		name string
+		size int
+		want [][]string
+	}{
+		{
+			name: "unlimited",
+			size: 0,
+			want: [][]string{{"key1", "key2", "key3", "key4", "key5"}},
+		},
+		{
+			name: "uneven",
+			size: 2,
+			want: [][]string{{"key1", "key2"}, {"key3", "key4"}, {"key5"}},
+		},
+		{
+			name: "larger than tags",
+			size: 10,
+			want: [][]string{{"key1", "key2", "key3", "key4", "key5"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var got [][]string
+
+			for _, chunk := range tags.Chunks(testCase.size) {
+				got = append(got, chunk.Keys())
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+
+	if got := New(nil).Chunks(2); len(got) != 0 {
+		t.Errorf("expected no chunks for empty tags, got %v", got)
+	}
+}
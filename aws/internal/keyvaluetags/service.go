@@ -0,0 +1,145 @@
+package keyvaluetags
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// DefaultRetryTimeout is the time tag operations failing with a retryable
+// error are retried for when a Service does not set its own RetryTimeout.
+const DefaultRetryTimeout = 2 * time.Minute
+
+// Service adapts the tagging API of an AWS service to the tags engine.
+type Service struct {
+	// Name of the service, used in log and error messages.
+	Name string
+
+	// ListTags returns all tags of the resource.
+	ListTags func(identifier string) (KeyValueTags, error)
+
+	// TagResource adds the tags to the resource, overwriting the values of
+	// existing keys.
+	TagResource func(identifier string, tags KeyValueTags) error
+
+	// UntagResource removes the tags from the resource. The tags are passed
+	// with their previous values for APIs which require them.
+	UntagResource func(identifier string, tags KeyValueTags) error
+
+	// TagBatchSize and UntagBatchSize limit the number of tags sent in each
+	// TagResource and UntagResource call. Zero means no limit.
+	TagBatchSize   int
+	UntagBatchSize int
+
+	// IsRetryable returns whether an error is caused by eventual consistency,
+	// e.g. a resource that was just created not being found yet, and the call
+	// should be retried.
+	IsRetryable func(err error) bool
+
+	// RetryTimeout defaults to DefaultRetryTimeout.
+	RetryTimeout time.Duration
+}
+
+// List returns the tags of the resource, excluding AWS reserved tags.
+func (s *Service) List(identifier string) (KeyValueTags, error) {
+	if s.ListTags == nil {
+		return nil, fmt.Errorf("listing %s tags is not supported", s.Name)
+	}
+
+	var tags KeyValueTags
+
+	err := s.retry(func() error {
+		var err error
+		tags, err = s.ListTags(identifier)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags for %s resource (%s): %s", s.Name, identifier, err)
+	}
+
+	return tags.IgnoreAws(), nil
+}
+
+// Update changes the tags of the resource from the old tags to the new tags,
+// accepting any type supported by New. AWS reserved tags are never changed.
+// Removed tags are untagged before new and changed tags are tagged, and both
+// are sent in the lexical order of their keys, split in batches.
+func (s *Service) Update(identifier string, oldTagsRaw, newTagsRaw interface{}) error {
+	oldTags := New(oldTagsRaw).IgnoreAws()
+	newTags := New(newTagsRaw).IgnoreAws()
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		if s.UntagResource == nil {
+			return fmt.Errorf("removing %s tags is not supported", s.Name)
+		}
+
+		for _, tags := range removedTags.Chunks(s.UntagBatchSize) {
+			log.Printf("[DEBUG] Removing %s tags from %s: %v", s.Name, identifier, tags.Keys())
+
+			err := s.retry(func() error {
+				return s.UntagResource(identifier, tags)
+			})
+
+			if err != nil {
+				return fmt.Errorf("error untagging %s resource (%s): %s", s.Name, identifier, err)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		if s.TagResource == nil {
+			return fmt.Errorf("adding %s tags is not supported", s.Name)
+		}
+
+		for _, tags := range updatedTags.Chunks(s.TagBatchSize) {
+			log.Printf("[DEBUG] Creating %s tags for %s: %v", s.Name, identifier, tags.Keys())
+
+			err := s.retry(func() error {
+				return s.TagResource(identifier, tags)
+			})
+
+			if err != nil {
+				return fmt.Errorf("error tagging %s resource (%s): %s", s.Name, identifier, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// retry calls f until it succeeds, fails with an error which is not
+// retryable or the retry timeout is reached, after which f is called a last
+// time to not fail on API throttling.
+func (s *Service) retry(f func() error) error {
+	if s.IsRetryable == nil {
+		return f()
+	}
+
+	timeout := s.RetryTimeout
+	if timeout == 0 {
+		timeout = DefaultRetryTimeout
+	}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		err := f()
+
+		if err != nil && s.IsRetryable(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if _, ok := err.(*resource.TimeoutError); ok {
+		err = f()
+	}
+
+	return err
+}
@@ -0,0 +1,187 @@
+package keyvaluetags
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeTaggedResource records the tagging API calls made by a Service.
+type fakeTaggedResource struct {
+	tags  map[string]string
+	calls []string
+
+	// failures is the number of calls failing with errRetryable before
+	// calls succeed.
+	failures int
+}
+
+var errRetryable = errors.New("ResourceNotFoundException")
+
+func (r *fakeTaggedResource) service() *Service {
+	return &Service{
+		Name: "Fake",
+		ListTags: func(identifier string) (KeyValueTags, error) {
+			if err := r.call("list", identifier, nil); err != nil {
+				return nil, err
+			}
+			return New(r.tags), nil
+		},
+		TagResource: func(identifier string, tags KeyValueTags) error {
+			if err := r.call("tag", identifier, tags); err != nil {
+				return err
+			}
+			for k, v := range tags.Map() {
+				r.tags[k] = v
+			}
+			return nil
+		},
+		UntagResource: func(identifier string, tags KeyValueTags) error {
+			if err := r.call("untag", identifier, tags); err != nil {
+				return err
+			}
+			for k := range tags {
+				delete(r.tags, k)
+			}
+			return nil
+		},
+		IsRetryable: func(err error) bool {
+			return err == errRetryable
+		},
+		RetryTimeout: 5 * time.Second,
+	}
+}
+
+func (r *fakeTaggedResource) call(operation, identifier string, tags KeyValueTags) error {
+	if r.failures > 0 {
+		r.failures--
+		return errRetryable
+	}
+	r.calls = append(r.calls, operation+" "+identifier+" "+strings.Join(tags.Keys(), ","))
+	return nil
+}
+
+func TestServiceUpdate(t *testing.T) {
+	r := &fakeTaggedResource{
+		tags: map[string]string{
+			"aws:cloudformation:stack-name": "stack",
+			"key1":                          "value1",
+			"key2":                          "value2",
+			"key3":                          "value3",
+			"key4":                          "value4",
+		},
+	}
+	s := r.service()
+	s.TagBatchSize = 2
+
+	err := s.Update("id", map[string]interface{}{
+		"aws:cloudformation:stack-name": "stack",
+		"key1":                          "value1",
+		"key2":                          "value2",
+		"key3":                          "value3",
+		"key4":                          "value4",
+	}, map[string]interface{}{
+		"key1": "value1",
+		"key3": "value3updated",
+		"key5": "value5",
+		"key6": "value6",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantCalls := []string{
+		"untag id key2,key4",
+		"tag id key3,key5",
+		"tag id key6",
+	}
+
+	if !reflect.DeepEqual(r.calls, wantCalls) {
+		t.Errorf("calls: got %v, want %v", r.calls, wantCalls)
+	}
+
+	wantTags := map[string]string{
+		"aws:cloudformation:stack-name": "stack",
+		"key1":                          "value1",
+		"key3":                          "value3updated",
+		"key5":                          "value5",
+		"key6":                          "value6",
+	}
+
+	if !reflect.DeepEqual(r.tags, wantTags) {
+		t.Errorf("tags: got %v, want %v", r.tags, wantTags)
+	}
+}
+
+func TestServiceUpdate_noChanges(t *testing.T) {
+	r := &fakeTaggedResource{tags: map[string]string{"key1": "value1"}}
+
+	err := r.service().Update("id", map[string]string{"key1": "value1"}, map[string]string{"key1": "value1"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(r.calls) != 0 {
+		t.Errorf("expected no calls, got %v", r.calls)
+	}
+}
+
+func TestServiceUpdate_retry(t *testing.T) {
+	r := &fakeTaggedResource{tags: map[string]string{}, failures: 1}
+
+	err := r.service().Update("id", nil, map[string]string{"key1": "value1"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"tag id key1"}; !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("calls: got %v, want %v", r.calls, want)
+	}
+}
+
+func TestServiceUpdate_error(t *testing.T) {
+	s := &Service{
+		Name: "Fake",
+		TagResource: func(identifier string, tags KeyValueTags) error {
+			return errors.New("AccessDenied")
+		},
+		IsRetryable: func(err error) bool {
+			return false
+		},
+	}
+
+	err := s.Update("id", nil, map[string]string{"key1": "value1"})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if want := "error tagging Fake resource (id): AccessDenied"; err.Error() != want {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+}
+
+func TestServiceList(t *testing.T) {
+	r := &fakeTaggedResource{
+		tags: map[string]string{
+			"aws:cloudformation:stack-name": "stack",
+			"key1":                          "value1",
+		},
+		failures: 1,
+	}
+
+	tags, err := r.service().List("id")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := map[string]string{"key1": "value1"}; !reflect.DeepEqual(tags.Map(), want) {
+		t.Errorf("got %v, want %v", tags.Map(), want)
+	}
+}
@@ -251,7 +251,10 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client := meta.(*AWSClient).opsworksconn
+				return lt.Import(d, client)
+			},
 		},
 
 		Schema: resourceSchema,
@@ -334,6 +337,33 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWo
 	return nil
 }
 
+func (lt *opsworksLayerType) Import(d *schema.ResourceData, client *opsworks.OpsWorks) ([]*schema.ResourceData, error) {
+	req := &opsworks.DescribeLayersInput{
+		LayerIds: []*string{
+			aws.String(d.Id()),
+		},
+	}
+
+	log.Printf("[DEBUG] Importing OpsWorks layer: %s", d.Id())
+
+	resp, err := client.DescribeLayers(req)
+	if err != nil {
+		return nil, fmt.Errorf("error describing OpsWorks layer (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.Layers) == 0 || resp.Layers[0] == nil {
+		return nil, fmt.Errorf("OpsWorks layer (%s) not found", d.Id())
+	}
+
+	// All layer resources share the same underlying API object, so make sure
+	// we're not importing e.g. a MySQL layer into an aws_opsworks_php_app_layer.
+	if layerType := aws.StringValue(resp.Layers[0].Type); layerType != lt.TypeName {
+		return nil, fmt.Errorf("OpsWorks layer (%s) has type %q, expected %q", d.Id(), layerType, lt.TypeName)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func (lt *opsworksLayerType) Create(d *schema.ResourceData, client *opsworks.OpsWorks) error {
 
 	req := &opsworks.CreateLayerInput{
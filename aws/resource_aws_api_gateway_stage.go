@@ -148,7 +148,7 @@ func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{})
 		}
 		input.Variables = aws.StringMap(variables)
 	}
-	if vars, ok := d.GetOk("tags"); ok {
+	if vars, ok := d.GetOk("tags_all"); ok {
 		newMap := make(map[string]string, len(vars.(map[string]interface{})))
 		for k, v := range vars.(map[string]interface{}) {
 			newMap[k] = v.(string)
@@ -281,6 +281,7 @@ func resourceAwsApiGatewayStageUpdate(d *schema.ResourceData, meta interface{})
 		return tagErr
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	operations := make([]*apigateway.PatchOperation, 0)
 	waitForCache := false
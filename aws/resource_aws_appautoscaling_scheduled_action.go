@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 		Create: resourceAwsAppautoscalingScheduledActionPut,
 		Read:   resourceAwsAppautoscalingScheduledActionRead,
 		Delete: resourceAwsAppautoscalingScheduledActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingScheduledActionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -147,6 +151,7 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 
 	saName := d.Get("name").(string)
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:           aws.String(d.Get("resource_id").(string)),
 		ScheduledActionNames: []*string{aws.String(saName)},
 		ServiceNamespace:     aws.String(d.Get("service_namespace").(string)),
 	}
@@ -162,13 +167,55 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 	if len(resp.ScheduledActions) != 1 {
 		return fmt.Errorf("Expected 1 scheduled action under %s, found %d", saName, len(resp.ScheduledActions))
 	}
-	if *resp.ScheduledActions[0].ScheduledActionName != saName {
+	sa := resp.ScheduledActions[0]
+	if *sa.ScheduledActionName != saName {
 		return fmt.Errorf("Scheduled Action (%s) not found", saName)
 	}
-	d.Set("arn", resp.ScheduledActions[0].ScheduledActionARN)
+	d.Set("arn", sa.ScheduledActionARN)
+	d.Set("name", sa.ScheduledActionName)
+	d.Set("resource_id", sa.ResourceId)
+	d.Set("scalable_dimension", sa.ScalableDimension)
+	d.Set("schedule", sa.Schedule)
+	d.Set("service_namespace", sa.ServiceNamespace)
+
+	if err := d.Set("scalable_target_action", flattenAppautoscalingScalableTargetAction(sa.ScalableTargetAction)); err != nil {
+		return fmt.Errorf("error setting scalable_target_action: %s", err)
+	}
+
+	if sa.StartTime != nil {
+		d.Set("start_time", sa.StartTime.Format(awsAppautoscalingScheduleTimeLayout))
+	}
+
+	if sa.EndTime != nil {
+		d.Set("end_time", sa.EndTime.Format(awsAppautoscalingScheduleTimeLayout))
+	}
+
 	return nil
 }
 
+func resourceAwsAppautoscalingScheduledActionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Resource IDs may themselves contain slashes, e.g. service/cluster/service.
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 3 {
+		return nil, fmt.Errorf("unexpected format (%q), expected <service-namespace>/<resource-id>/<scheduled-action-name>", d.Id())
+	}
+
+	serviceNamespace := idParts[0]
+	resourceId := strings.Join(idParts[1:len(idParts)-1], "/")
+	name := idParts[len(idParts)-1]
+
+	if serviceNamespace == "" || resourceId == "" || name == "" {
+		return nil, fmt.Errorf("unexpected format (%q), expected <service-namespace>/<resource-id>/<scheduled-action-name>", d.Id())
+	}
+
+	d.Set("service_namespace", serviceNamespace)
+	d.Set("resource_id", resourceId)
+	d.Set("name", name)
+	d.SetId(name + "-" + serviceNamespace + "-" + resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAppautoscalingScheduledActionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appautoscalingconn
 
@@ -191,3 +238,19 @@ func resourceAwsAppautoscalingScheduledActionDelete(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func flattenAppautoscalingScalableTargetAction(sta *applicationautoscaling.ScalableTargetAction) []interface{} {
+	if sta == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+	if sta.MaxCapacity != nil {
+		m["max_capacity"] = int(aws.Int64Value(sta.MaxCapacity))
+	}
+	if sta.MinCapacity != nil {
+		m["min_capacity"] = int(aws.Int64Value(sta.MinCapacity))
+	}
+
+	return []interface{}{m}
+}
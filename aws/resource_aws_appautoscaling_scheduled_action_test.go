@@ -25,6 +25,12 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.hoge"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_scheduled_action.hoge",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingScheduledActionImportStateIdFunc("aws_appautoscaling_scheduled_action.hoge"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -113,6 +119,17 @@ func testAccCheckAwsAppautoscalingScheduledActionExists(name string) resource.Te
 	}
 }
 
+func testAccAWSAppautoscalingScheduledActionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccAppautoscalingScheduledActionConfig_DynamoDB(rName, ts string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "hoge" {
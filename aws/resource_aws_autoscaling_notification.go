@@ -16,6 +16,9 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Read:   resourceAwsAutoscalingNotificationRead,
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingNotificationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": {
@@ -115,6 +118,14 @@ func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+func resourceAwsAutoscalingNotificationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// With no group_names in state, Read describes the notification
+	// configurations of all groups and keeps those sent to this topic.
+	d.Set("topic_arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsAutoscalingSchedule() *schema.Resource {
 		Read:   resourceAwsAutoscalingScheduleRead,
 		Update: resourceAwsAutoscalingScheduleCreate,
 		Delete: resourceAwsAutoscalingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingScheduleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -142,6 +146,7 @@ func resourceAwsAutoscalingScheduleRead(d *schema.ResourceData, meta interface{}
 
 	d.Set("autoscaling_group_name", sa.AutoScalingGroupName)
 	d.Set("arn", sa.ScheduledActionARN)
+	d.Set("scheduled_action_name", sa.ScheduledActionName)
 
 	if sa.MinSize == nil {
 		d.Set("min_size", -1)
@@ -172,6 +177,22 @@ func resourceAwsAutoscalingScheduleRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceAwsAutoscalingScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format (%q), expected <asg-name>/<scheduled-action-name>", d.Id())
+	}
+
+	asgName := idParts[0]
+	scheduledActionName := idParts[1]
+
+	d.Set("autoscaling_group_name", asgName)
+	d.Set("scheduled_action_name", scheduledActionName)
+	d.SetId(scheduledActionName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
 	autoscalingconn := meta.(*AWSClient).autoscalingconn
 
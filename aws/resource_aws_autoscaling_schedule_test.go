@@ -29,6 +29,12 @@ func TestAccAWSAutoscalingSchedule_basic(t *testing.T) {
 					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_schedule.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingScheduleImportStateIdFunc("aws_autoscaling_schedule.foobar"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -217,6 +223,17 @@ func testAccCheckScalingScheduleHasNoDesiredCapacity(
 	}
 }
 
+func testAccAWSAutoscalingScheduleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["scheduled_action_name"]), nil
+	}
+}
+
 func testAccAWSAutoscalingScheduleConfig(r, start, end string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_configuration" "foobar" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudFormationStack() *schema.Resource {
@@ -155,7 +156,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
@@ -385,8 +386,24 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	// Tags are replaced as a whole, an empty list removes all tags
+	if d.HasChange("tags_all") {
+		resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(d.Id()),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading CloudFormation stack (%s): %s", d.Id(), err)
+		}
+
+		var remoteTags keyvaluetags.KeyValueTags
+		if len(resp.Stacks) > 0 {
+			remoteTags = keyvaluetags.New(flattenCloudFormationTags(resp.Stacks[0].Tags))
+		}
+		tags := tagsMergeIgnored(meta, d.Get("tags_all").(map[string]interface{}), remoteTags)
+		input.Tags = expandCloudFormationTags(tagsMapToRaw(tags.Map()))
+		if input.Tags == nil {
+			input.Tags = []*cloudformation.Tag{}
+		}
 	}
 
 	if d.HasChange("policy_body") {
@@ -0,0 +1,483 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsCloudFormationStackSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetCreate,
+		Read:   resourceAwsCloudFormationStackSetRead,
+		Update: resourceAwsCloudFormationStackSetUpdate,
+		Delete: resourceAwsCloudFormationStackSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"administration_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateArn,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cloudformation.CapabilityCapabilityAutoExpand,
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AWSCloudFormationStackSetExecutionRole",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,127}$`), "must begin with alphabetic character, only contain alphanumeric and hyphen characters and be at most 128 characters"),
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"stack_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"template_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"template_url"},
+				ValidateFunc:  validateCloudFormationTemplate,
+				StateFunc: func(v interface{}) string {
+					template, _ := normalizeCloudFormationTemplate(v)
+					return template
+				},
+			},
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+		},
+	}
+}
+
+// cloudFormationStackSetOperationPreferencesSchema returns the schema of the
+// preferences applied to the operations a StackSet runs against its stack
+// instances.
+func cloudFormationStackSetOperationPreferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failure_tolerance_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(0),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+				},
+				"failure_tolerance_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(0, 100),
+					ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+				},
+				"max_concurrent_count": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+				},
+				"max_concurrent_percentage": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntBetween(1, 100),
+					ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+				},
+				"region_order": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+	name := d.Get("name").(string)
+
+	input := &cloudformation.CreateStackSetInput{
+		ExecutionRoleName: aws.String(d.Get("execution_role_name").(string)),
+		StackSetName:      aws.String(name),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation StackSet: %s", input)
+	_, err := conn.CreateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("error creating CloudFormation StackSet: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	input := &cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading CloudFormation StackSet: %s", d.Id())
+	output, err := conn.DescribeStackSet(input)
+
+	if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.StackSet == nil {
+		return fmt.Errorf("error reading CloudFormation StackSet (%s): empty response", d.Id())
+	}
+
+	stackSet := output.StackSet
+
+	if aws.StringValue(stackSet.Status) == cloudformation.StackSetStatusDeleted {
+		log.Printf("[WARN] CloudFormation StackSet (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("administration_role_arn", stackSet.AdministrationRoleARN)
+	d.Set("arn", stackSet.StackSetARN)
+
+	if err := d.Set("capabilities", schema.NewSet(schema.HashString, flattenStringList(stackSet.Capabilities))); err != nil {
+		return fmt.Errorf("error setting capabilities: %s", err)
+	}
+
+	d.Set("description", stackSet.Description)
+	d.Set("execution_role_name", stackSet.ExecutionRoleName)
+	d.Set("name", stackSet.StackSetName)
+
+	if err := d.Set("parameters", flattenAllCloudFormationParameters(stackSet.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	d.Set("stack_set_id", stackSet.StackSetId)
+
+	if err := d.Set("tags", flattenCloudFormationTags(stackSet.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	template, err := normalizeCloudFormationTemplate(aws.StringValue(stackSet.TemplateBody))
+	if err != nil {
+		return fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+	}
+	d.Set("template_body", template)
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	// Updates are applied to all stack instances, so changing only the
+	// operation preferences does not trigger an update operation
+	if !d.HasChange("administration_role_arn") && !d.HasChange("capabilities") && !d.HasChange("description") && !d.HasChange("execution_role_name") && !d.HasChange("parameters") && !d.HasChange("tags_all") && !d.HasChange("template_body") && !d.HasChange("template_url") {
+		return resourceAwsCloudFormationStackSetRead(d, meta)
+	}
+
+	input := &cloudformation.UpdateStackSetInput{
+		ExecutionRoleName:    aws.String(d.Get("execution_role_name").(string)),
+		OperationId:          aws.String(resource.UniqueId()),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		StackSetName:         aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	// Capabilities must be present whether they are changed or not
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	// Parameters must be present whether they are changed or not
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	// Tags are replaced as a whole, an empty list removes all tags
+	describeOutput, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	var remoteTags keyvaluetags.KeyValueTags
+	if describeOutput.StackSet != nil {
+		remoteTags = keyvaluetags.New(flattenCloudFormationTags(describeOutput.StackSet.Tags))
+	}
+	tags := tagsMergeIgnored(meta, d.Get("tags_all").(map[string]interface{}), remoteTags)
+	input.Tags = expandCloudFormationTags(tagsMapToRaw(tags.Map()))
+	if input.Tags == nil {
+		input.Tags = []*cloudformation.Tag{}
+	}
+
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := normalizeCloudFormationTemplate(v)
+		if err != nil {
+			return fmt.Errorf("template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	log.Printf("[DEBUG] Updating CloudFormation StackSet: %s", input)
+	output, err := conn.UpdateStackSet(input)
+	if err != nil {
+		return fmt.Errorf("error updating CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, d.Id(), aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	input := &cloudformation.DeleteStackSetInput{
+		StackSetName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation StackSet: %s", d.Id())
+	_, err := conn.DeleteStackSet(input)
+
+	if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFormation StackSet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFormationStackSetOperationPreferences(l []interface{}) *cloudformation.StackSetOperationPreferences {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	preferences := &cloudformation.StackSetOperationPreferences{}
+
+	// Zero is a valid failure tolerance, so the count is always sent unless
+	// the percentage is used instead
+	if v, ok := m["failure_tolerance_percentage"].(int); ok && v > 0 {
+		preferences.FailureTolerancePercentage = aws.Int64(int64(v))
+	} else if v, ok := m["failure_tolerance_count"].(int); ok {
+		preferences.FailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_concurrent_percentage"].(int); ok && v > 0 {
+		preferences.MaxConcurrentPercentage = aws.Int64(int64(v))
+	} else if v, ok := m["max_concurrent_count"].(int); ok && v > 0 {
+		preferences.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["region_order"].([]interface{}); ok && len(v) > 0 {
+		preferences.RegionOrder = expandStringList(v)
+	}
+
+	return preferences
+}
+
+// waitForCloudFormationStackSetOperation waits for a StackSet operation to
+// complete. When the operation fails, the reasons reported for the failed
+// stack instances are returned in the error.
+func waitForCloudFormationStackSetOperation(conn *cloudformation.CloudFormation, stackSetName, operationID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackSetOperationStatusRunning,
+			cloudformation.StackSetOperationStatusStopping,
+		},
+		Target:  []string{cloudformation.StackSetOperationStatusSucceeded},
+		Refresh: cloudFormationStackSetOperationRefreshFunc(conn, stackSetName, operationID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+		// Handle eventual consistency of newly started operations
+		NotFoundChecks: 20,
+	}
+
+	log.Printf("[DEBUG] Waiting for CloudFormation StackSet (%s) operation: %s", stackSetName, operationID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func cloudFormationStackSetOperationRefreshFunc(conn *cloudformation.CloudFormation, stackSetName, operationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+			OperationId:  aws.String(operationID),
+			StackSetName: aws.String(stackSetName),
+		})
+
+		if isAWSErr(err, cloudformation.ErrCodeOperationNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error describing CloudFormation StackSet (%s) operation (%s): %s", stackSetName, operationID, err)
+		}
+
+		if output == nil || output.StackSetOperation == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(output.StackSetOperation.Status)
+
+		if status == cloudformation.StackSetOperationStatusFailed || status == cloudformation.StackSetOperationStatusStopped {
+			reasons, err := getCloudFormationStackSetOperationFailures(conn, stackSetName, operationID)
+			if err != nil {
+				return output.StackSetOperation, status, fmt.Errorf("operation %s: failed getting failure reasons: %s", status, err)
+			}
+
+			return output.StackSetOperation, status, fmt.Errorf("operation %s: %s", status, strings.Join(reasons, ", "))
+		}
+
+		return output.StackSetOperation, status, nil
+	}
+}
+
+func getCloudFormationStackSetOperationFailures(conn *cloudformation.CloudFormation, stackSetName, operationID string) ([]string, error) {
+	var failures []string
+
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		OperationId:  aws.String(operationID),
+		StackSetName: aws.String(stackSetName),
+	}
+
+	for {
+		output, err := conn.ListStackSetOperationResults(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range output.Summaries {
+			if summary == nil || aws.StringValue(summary.Status) == cloudformation.StackSetOperationResultStatusSucceeded {
+				continue
+			}
+
+			reason := aws.StringValue(summary.StatusReason)
+			if reason == "" && summary.AccountGateResult != nil {
+				reason = aws.StringValue(summary.AccountGateResult.StatusReason)
+			}
+
+			failures = append(failures, fmt.Sprintf("Account (%s) Region (%s) Status (%s) Status Reason: %s", aws.StringValue(summary.Account), aws.StringValue(summary.Region), aws.StringValue(summary.Status), reason))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return failures, nil
+}
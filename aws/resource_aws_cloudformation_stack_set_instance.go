@@ -0,0 +1,280 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationStackSetInstanceCreate,
+		Read:   resourceAwsCloudFormationStackSetInstanceRead,
+		Update: resourceAwsCloudFormationStackSetInstanceUpdate,
+		Delete: resourceAwsCloudFormationStackSetInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
+			"parameter_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"retain_stack": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	stackSetName := d.Get("stack_set_name").(string)
+
+	input := &cloudformation.CreateStackInstancesInput{
+		Accounts:             aws.StringSlice([]string{accountID}),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		Regions:              aws.StringSlice([]string{region}),
+		StackSetName:         aws.String(stackSetName),
+	}
+
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation StackSet Instance: %s", input)
+	var output *cloudformation.CreateStackInstancesOutput
+	// Only one operation can run against a StackSet at a time
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateStackInstances(input)
+
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFormation StackSet Instance: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountID, region))
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DescribeStackInstanceInput{
+		StackInstanceAccount: aws.String(accountID),
+		StackInstanceRegion:  aws.String(region),
+		StackSetName:         aws.String(stackSetName),
+	}
+
+	log.Printf("[DEBUG] Reading CloudFormation StackSet Instance: %s", d.Id())
+	output, err := conn.DescribeStackInstance(input)
+
+	if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.StackInstance == nil {
+		return fmt.Errorf("error reading CloudFormation StackSet Instance (%s): empty response", d.Id())
+	}
+
+	stackInstance := output.StackInstance
+
+	d.Set("account_id", stackInstance.Account)
+
+	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(stackInstance.ParameterOverrides)); err != nil {
+		return fmt.Errorf("error setting parameter_overrides: %s", err)
+	}
+
+	d.Set("region", stackInstance.Region)
+	d.Set("stack_id", stackInstance.StackId)
+	d.Set("stack_set_name", stackSetName)
+
+	return nil
+}
+
+func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	if !d.HasChange("parameter_overrides") {
+		return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+	}
+
+	stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.UpdateStackInstancesInput{
+		Accounts:             aws.StringSlice([]string{accountID}),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		// An empty list removes all previously overridden parameters
+		ParameterOverrides: []*cloudformation.Parameter{},
+		Regions:            aws.StringSlice([]string{region}),
+		StackSetName:       aws.String(stackSetName),
+	}
+
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating CloudFormation StackSet Instance: %s", input)
+	var output *cloudformation.UpdateStackInstancesOutput
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		var err error
+		output, err = conn.UpdateStackInstances(input)
+
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudFormationStackSetInstanceRead(d, meta)
+}
+
+func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &cloudformation.DeleteStackInstancesInput{
+		Accounts:             aws.StringSlice([]string{accountID}),
+		OperationPreferences: expandCloudFormationStackSetOperationPreferences(d.Get("operation_preferences").([]interface{})),
+		Regions:              aws.StringSlice([]string{region}),
+		RetainStacks:         aws.Bool(d.Get("retain_stack").(bool)),
+		StackSetName:         aws.String(stackSetName),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation StackSet Instance: %s", input)
+	var output *cloudformation.DeleteStackInstancesOutput
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		var err error
+		output, err = conn.DeleteStackInstances(input)
+
+		if isAWSErr(err, cloudformation.ErrCodeOperationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeCloudFormationStackSetInstanceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected STACKSETNAME,ACCOUNTID,REGION", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,536 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+		Name: "aws_cloudformation_stack_set_instance",
+		F:    testSweepCloudformationStackSetInstances,
+	})
+}
+
+func testSweepCloudformationStackSetInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).cfconn
+	input := &cloudformation.ListStackSetsInput{
+		Status: aws.String(cloudformation.StackSetStatusActive),
+	}
+
+	for {
+		output, err := conn.ListStackSets(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudFormation StackSet Instance sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudFormation StackSets: %s", err)
+		}
+
+		for _, summary := range output.Summaries {
+			stackSetName := aws.StringValue(summary.StackSetName)
+
+			if !strings.HasPrefix(stackSetName, "tf-acc-test-") {
+				continue
+			}
+
+			if err := testSweepCloudformationStackSetInstancesForStackSet(conn, stackSetName); err != nil {
+				return err
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func testSweepCloudformationStackSetInstancesForStackSet(conn *cloudformation.CloudFormation, stackSetName string) error {
+	input := &cloudformation.ListStackInstancesInput{
+		StackSetName: aws.String(stackSetName),
+	}
+
+	for {
+		output, err := conn.ListStackInstances(input)
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudFormation StackSet (%s) Instances: %s", stackSetName, err)
+		}
+
+		for _, summary := range output.Summaries {
+			accountID := aws.StringValue(summary.Account)
+			region := aws.StringValue(summary.Region)
+			id := fmt.Sprintf("%s,%s,%s", stackSetName, accountID, region)
+
+			log.Printf("[INFO] Deleting CloudFormation StackSet Instance: %s", id)
+			output, err := conn.DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+				Accounts:     aws.StringSlice([]string{accountID}),
+				Regions:      aws.StringSlice([]string{region}),
+				RetainStacks: aws.Bool(false),
+				StackSetName: aws.String(stackSetName),
+			})
+
+			if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error deleting CloudFormation StackSet Instance (%s): %s", id, err)
+			}
+
+			if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), 30*time.Minute); err != nil {
+				return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) deletion: %s", id, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
+	var stackInstance cloudformation.StackInstance
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	cloudformationStackSetResourceName := "aws_cloudformation_stack_set.test"
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+					resource.TestCheckResourceAttr(resourceName, "retain_stack", "false"),
+					resource.TestMatchResourceAttr(resourceName, "stack_id", regexp.MustCompile(`^arn:[^:]+:cloudformation:[^:]+:[^:]+:stack/StackSet-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", cloudformationStackSetResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_stack",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_disappears(t *testing.T) {
+	var stackInstance cloudformation.StackInstance
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					testAccCheckCloudFormationStackSetInstanceDisappears(&stackInstance),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_ParameterOverrides(t *testing.T) {
+	var stackInstance1, stackInstance2, stackInstance3 cloudformation.StackInstance
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides1(rName, "overridevalue1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance1),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.Parameter1", "overridevalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_preferences",
+					"retain_stack",
+				},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides1(rName, "overridevalue2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance2),
+					testAccCheckCloudFormationStackSetInstanceNotRecreated(&stackInstance1, &stackInstance2),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.Parameter1", "overridevalue2"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance3),
+					testAccCheckCloudFormationStackSetInstanceNotRecreated(&stackInstance2, &stackInstance3),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_RetainStack(t *testing.T) {
+	var stack cloudformation.Stack
+	var stackInstance cloudformation.StackInstance
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigRetainStack(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance),
+					resource.TestCheckResourceAttr(resourceName, "retain_stack", "true"),
+				),
+			},
+			{
+				// Removing the instance must leave its stack in place
+				Config: testAccAWSCloudFormationStackSetInstanceConfigBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceStackExists(&stackInstance, &stack),
+					testAccCheckCloudFormationStackDisappears(&stack),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stackInstance *cloudformation.StackInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.StackInstance == nil {
+			return fmt.Errorf("CloudFormation StackSet Instance (%s) not found", rs.Primary.ID)
+		}
+
+		*stackInstance = *output.StackInstance
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudformation.StackInstance, stack *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		output, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: stackInstance.StackId,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || len(output.Stacks) == 0 || output.Stacks[0] == nil {
+			return fmt.Errorf("CloudFormation Stack (%s) not found", aws.StringValue(stackInstance.StackId))
+		}
+
+		*stack = *output.Stacks[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set_instance" {
+			continue
+		}
+
+		stackSetName, accountID, region, err := decodeCloudFormationStackSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.DescribeStackInstance(&cloudformation.DescribeStackInstanceInput{
+			StackInstanceAccount: aws.String(accountID),
+			StackInstanceRegion:  aws.String(region),
+			StackSetName:         aws.String(stackSetName),
+		})
+
+		if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") || isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.StackInstance != nil {
+			return fmt.Errorf("CloudFormation StackSet Instance (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFormationStackSetInstanceDisappears(stackInstance *cloudformation.StackInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		// DescribeStackInstance only returns the StackSet ID, which
+		// StackSet operations accept in place of the name
+		input := &cloudformation.DeleteStackInstancesInput{
+			Accounts:     []*string{stackInstance.Account},
+			Regions:      []*string{stackInstance.Region},
+			RetainStacks: aws.Bool(false),
+			StackSetName: stackInstance.StackSetId,
+		}
+
+		output, err := conn.DeleteStackInstances(input)
+
+		if err != nil {
+			return err
+		}
+
+		return waitForCloudFormationStackSetOperation(conn, aws.StringValue(stackInstance.StackSetId), aws.StringValue(output.OperationId), 10*time.Minute)
+	}
+}
+
+func testAccCheckCloudFormationStackSetInstanceNotRecreated(i, j *cloudformation.StackInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.StackId) != aws.StringValue(j.StackId) {
+			return fmt.Errorf("CloudFormation StackSet Instance (%s,%s,%s) recreated", aws.StringValue(i.StackSetId), aws.StringValue(i.Account), aws.StringValue(i.Region))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFormationStackDisappears(stack *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		_, err := conn.DeleteStack(&cloudformation.DeleteStackInput{
+			StackName: stack.StackId,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "Administration" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    effect  = "Allow"
+
+    principals {
+      identifiers = ["cloudformation.amazonaws.com"]
+      type        = "Service"
+    }
+  }
+}
+
+resource "aws_iam_role" "Administration" {
+  assume_role_policy = "${data.aws_iam_policy_document.Administration.json}"
+  name               = "%[1]s-Administration"
+}
+
+resource "aws_iam_role_policy" "Administration" {
+  role = "${aws_iam_role.Administration.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Resource": "${aws_iam_role.Execution.arn}"
+    }
+  ]
+}
+POLICY
+}
+
+data "aws_iam_policy_document" "Execution" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    effect  = "Allow"
+
+    principals {
+      identifiers = ["${aws_iam_role.Administration.arn}"]
+      type        = "AWS"
+    }
+  }
+}
+
+resource "aws_iam_role" "Execution" {
+  assume_role_policy = "${data.aws_iam_policy_document.Execution.json}"
+  name               = "%[1]s-Execution"
+}
+
+resource "aws_iam_role_policy" "Execution" {
+  role = "${aws_iam_role.Execution.name}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_cloudformation_stack_set" "test" {
+  depends_on = ["aws_iam_role_policy.Execution"]
+
+  administration_role_arn = "${aws_iam_role.Administration.arn}"
+  execution_role_name     = "${aws_iam_role.Execution.name}"
+  name                    = %[1]q
+
+  parameters = {
+    Parameter1 = "stacksetvalue1"
+    Parameter2 = "stacksetvalue2"
+  }
+
+  template_body = <<TEMPLATE
+Parameters:
+  Parameter1:
+    Type: String
+  Parameter2:
+    Type: String
+
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+      Tags:
+        - Key: Name
+          Value: %[1]q
+
+Outputs:
+  Parameter1Value:
+    Value: !Ref Parameter1
+  Parameter2Value:
+    Value: !Ref Parameter2
+TEMPLATE
+}
+`, rName)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfig(rName string) string {
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + `
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = ["aws_iam_role_policy.Administration", "aws_iam_role_policy.Execution"]
+
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+}
+`
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides1(rName, value1 string) string {
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = ["aws_iam_role_policy.Administration", "aws_iam_role_policy.Execution"]
+
+  parameter_overrides = {
+    Parameter1 = %[1]q
+  }
+
+  operation_preferences {
+    failure_tolerance_count = 0
+    max_concurrent_count    = 1
+  }
+
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+}
+`, value1)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigRetainStack(rName string, retainStack bool) string {
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = ["aws_iam_role_policy.Administration", "aws_iam_role_policy.Execution"]
+
+  retain_stack   = %[1]t
+  stack_set_name = "${aws_cloudformation_stack_set.test.name}"
+}
+`, retainStack)
+}
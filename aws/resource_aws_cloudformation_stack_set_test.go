@@ -0,0 +1,602 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+		Name: "aws_cloudformation_stack_set",
+		Dependencies: []string{
+			"aws_cloudformation_stack_set_instance",
+		},
+		F: testSweepCloudformationStackSets,
+	})
+}
+
+func testSweepCloudformationStackSets(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).cfconn
+	input := &cloudformation.ListStackSetsInput{
+		Status: aws.String(cloudformation.StackSetStatusActive),
+	}
+
+	for {
+		output, err := conn.ListStackSets(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudFormation StackSet sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudFormation StackSets: %s", err)
+		}
+
+		for _, summary := range output.Summaries {
+			name := aws.StringValue(summary.StackSetName)
+
+			if !strings.HasPrefix(name, "tf-acc-test-") {
+				log.Printf("[INFO] Skipping CloudFormation StackSet: %s", name)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting CloudFormation StackSet: %s", name)
+			_, err := conn.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+				StackSetName: aws.String(name),
+			})
+
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error deleting CloudFormation StackSet (%s): %s", name, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSCloudFormationStackSet_basic(t *testing.T) {
+	var stackSet cloudformation.StackSet
+	iamRoleResourceName := "aws_iam_role.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttrPair(resourceName, "administration_role_arn", iamRoleResourceName, "arn"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:cloudformation:[^:]+:[^:]+:stackset/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "execution_role_name", "AWSCloudFormationStackSetExecutionRole"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestMatchResourceAttr(resourceName, "stack_set_id", regexp.MustCompile(fmt.Sprintf("^%s:.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_body", testAccAWSCloudFormationStackSetTemplateBodyVpc(rName)+"\n"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template_url",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_disappears(t *testing.T) {
+	var stackSet cloudformation.StackSet
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet),
+					testAccCheckCloudFormationStackSetDisappears(&stackSet),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_Description(t *testing.T) {
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigDescription(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template_url",
+				},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfigDescription(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet2),
+					testAccCheckCloudFormationStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_Parameters(t *testing.T) {
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigParameters(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.Parameter1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template_url",
+				},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfigParameters(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet2),
+					testAccCheckCloudFormationStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.Parameter1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_Tags(t *testing.T) {
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigTags1(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template_url",
+				},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfigTags1(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet2),
+					testAccCheckCloudFormationStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_Tags_IgnoreTags(t *testing.T) {
+	var stackSet cloudformation.StackSet
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigTagsIgnoreTags(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet),
+					testAccCheckCloudFormationStackSetAddTag(&stackSet, "IgnoredKey", "external"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfigTagsIgnoreTags(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+					testAccCheckCloudFormationStackSetTag(&stackSet, "IgnoredKey", "external"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSet_TemplateUrl(t *testing.T) {
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetConfigTemplateUrl(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet1),
+					resource.TestCheckResourceAttrSet(resourceName, "template_body"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetConfigTemplateUrl(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet2),
+					testAccCheckCloudFormationStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestMatchResourceAttr(resourceName, "template_body", regexp.MustCompile(`10\.1\.0\.0/16`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		output, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.StackSet == nil {
+			return fmt.Errorf("CloudFormation StackSet (%s) not found", rs.Primary.ID)
+		}
+
+		*stackSet = *output.StackSet
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_set" {
+			continue
+		}
+
+		output, err := conn.DescribeStackSet(&cloudformation.DescribeStackSetInput{
+			StackSetName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.StackSet != nil && aws.StringValue(output.StackSet.Status) != cloudformation.StackSetStatusDeleted {
+			return fmt.Errorf("CloudFormation StackSet (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFormationStackSetDisappears(stackSet *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		_, err := conn.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+			StackSetName: stackSet.StackSetName,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckCloudFormationStackSetAddTag(stackSet *cloudformation.StackSet, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		tags := append(stackSet.Tags, &cloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+
+		output, err := conn.UpdateStackSet(&cloudformation.UpdateStackSetInput{
+			AdministrationRoleARN: stackSet.AdministrationRoleARN,
+			Capabilities:          stackSet.Capabilities,
+			ExecutionRoleName:     stackSet.ExecutionRoleName,
+			Parameters:            stackSet.Parameters,
+			StackSetName:          stackSet.StackSetName,
+			Tags:                  tags,
+			UsePreviousTemplate:   aws.Bool(true),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForCloudFormationStackSetOperation(conn, aws.StringValue(stackSet.StackSetName), aws.StringValue(output.OperationId), 10*time.Minute)
+	}
+}
+
+func testAccCheckCloudFormationStackSetTag(stackSet *cloudformation.StackSet, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags := flattenCloudFormationTags(stackSet.Tags)
+
+		if v, ok := tags[key]; !ok || v != value {
+			return fmt.Errorf("CloudFormation StackSet (%s) tag %q: expected %q, got %q", aws.StringValue(stackSet.StackSetName), key, value, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFormationStackSetNotRecreated(i, j *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.StackSetId) != aws.StringValue(j.StackSetId) {
+			return fmt.Errorf("CloudFormation StackSet (%s) recreated", aws.StringValue(i.StackSetName))
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSCloudFormationStackSet(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	_, err := conn.ListStackSets(&cloudformation.ListStackSetsInput{})
+
+	if testAccPreCheckSkipError(err) || isAWSErr(err, "ValidationError", "StackSets is not supported in this region") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSCloudFormationStackSetTemplateBodyVpc(rName string) string {
+	return fmt.Sprintf(`
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+      Tags:
+        - Key: Name
+          Value: %[1]q
+
+Outputs:
+  TestVpcID:
+    Value: !Ref TestVpc
+`, rName)
+}
+
+func testAccAWSCloudFormationStackSetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = ["sts:AssumeRole"]
+    effect  = "Allow"
+
+    principals {
+      identifiers = ["cloudformation.amazonaws.com"]
+      type        = "Service"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  assume_role_policy = "${data.aws_iam_policy_document.test.json}"
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudFormationStackSetConfigName(rName string) string {
+	return testAccAWSCloudFormationStackSetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = "${aws_iam_role.test.arn}"
+  name                    = %[1]q
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBodyVpc(rName))
+}
+
+func testAccAWSCloudFormationStackSetConfigDescription(rName, description string) string {
+	return testAccAWSCloudFormationStackSetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = "${aws_iam_role.test.arn}"
+  description             = %[3]q
+  name                    = %[1]q
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBodyVpc(rName), description)
+}
+
+func testAccAWSCloudFormationStackSetConfigParameters(rName, value1 string) string {
+	return testAccAWSCloudFormationStackSetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = "${aws_iam_role.test.arn}"
+  name                    = %[1]q
+
+  parameters = {
+    Parameter1 = %[2]q
+  }
+
+  template_body = <<TEMPLATE
+Parameters:
+  Parameter1:
+    Type: String
+
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+      Tags:
+        - Key: Name
+          Value: !Ref Parameter1
+TEMPLATE
+}
+`, rName, value1)
+}
+
+func testAccAWSCloudFormationStackSetConfigTags1(rName, value1 string) string {
+	return testAccAWSCloudFormationStackSetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = "${aws_iam_role.test.arn}"
+  name                    = %[1]q
+
+  tags = {
+    Key1 = %[3]q
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccAWSCloudFormationStackSetTemplateBodyVpc(rName), value1)
+}
+
+func testAccAWSCloudFormationStackSetConfigTagsIgnoreTags(rName, value1 string) string {
+	return `
+provider "aws" {
+  ignore_tags {
+    keys = ["IgnoredKey"]
+  }
+}
+` + testAccAWSCloudFormationStackSetConfigTags1(rName, value1)
+}
+
+func testAccAWSCloudFormationStackSetConfigTemplateUrl(rName, cidrBlock string) string {
+	// The object key changes with the template so the StackSet sees a new URL
+	key := fmt.Sprintf("%s-%s.yml", rName, strings.NewReplacer(".", "-", "/", "-").Replace(cidrBlock))
+
+	return testAccAWSCloudFormationStackSetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  acl    = "public-read"
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  acl    = "public-read"
+  bucket = "${aws_s3_bucket.test.bucket}"
+  key    = %[3]q
+
+  content = <<CONTENT
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: %[2]s
+      Tags:
+        - Key: Name
+          Value: %[1]q
+CONTENT
+}
+
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = "${aws_iam_role.test.arn}"
+  name                    = %[1]q
+  template_url            = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+}
+`, rName, cidrBlock, key)
+}
@@ -237,6 +237,44 @@ func TestAccAWSCloudFormationStack_withUrl_withParams_noUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormationStack_Tags_IgnoreTags(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := fmt.Sprintf("tf-acc-test-tags-%s", acctest.RandString(10))
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackConfig_Tags_IgnoreTags(stackName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					testAccCheckCloudFormationStackAddTag(&stack, "IgnoredKey", "external"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackConfig_Tags_IgnoreTags(stackName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+					testAccCheckCloudFormationStackTag(&stack, "IgnoredKey", "external"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFormationStackConfig_Tags_IgnoreTags_NoTags(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					testAccCheckCloudFormationStackTag(&stack, "IgnoredKey", "external"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -256,6 +294,45 @@ func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack
 			return fmt.Errorf("CloudFormation stack not found")
 		}
 
+		*stack = *resp.Stacks[0]
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFormationStackAddTag(stack *cloudformation.Stack, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		tags := append(stack.Tags, &cloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+
+		_, err := conn.UpdateStack(&cloudformation.UpdateStackInput{
+			StackName:           stack.StackId,
+			Tags:                tags,
+			UsePreviousTemplate: aws.Bool(true),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return conn.WaitUntilStackUpdateComplete(&cloudformation.DescribeStacksInput{
+			StackName: stack.StackId,
+		})
+	}
+}
+
+func testAccCheckCloudFormationStackTag(stack *cloudformation.Stack, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags := flattenCloudFormationTags(stack.Tags)
+
+		if v, ok := tags[key]; !ok || v != value {
+			return fmt.Errorf("CloudFormation stack (%s) tag %q: expected %q, got %q", aws.StringValue(stack.StackName), key, value, v)
+		}
+
 		return nil
 	}
 }
@@ -653,3 +730,42 @@ resource "aws_cloudformation_stack" "with-url-and-params-and-yaml" {
 }
 `, rName, bucketKey, vpcCidr)
 }
+
+func testAccAWSCloudFormationStackConfig_Tags_IgnoreTags_Base(stackName, tags string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    keys = ["IgnoredKey"]
+  }
+}
+
+resource "aws_cloudformation_stack" "test" {
+  name = "%[1]s"
+%[2]s
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16"
+      }
+    }
+  }
+}
+STACK
+}
+`, stackName, tags)
+}
+
+func testAccAWSCloudFormationStackConfig_Tags_IgnoreTags(stackName, value1 string) string {
+	return testAccAWSCloudFormationStackConfig_Tags_IgnoreTags_Base(stackName, fmt.Sprintf(`
+  tags = {
+    Key1 = %[1]q
+  }
+`, value1))
+}
+
+func testAccAWSCloudFormationStackConfig_Tags_IgnoreTags_NoTags(stackName string) string {
+	return testAccAWSCloudFormationStackConfig_Tags_IgnoreTags_Base(stackName, "")
+}
@@ -0,0 +1,1036 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudSearchDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudSearchDomainCreate,
+		Read:   resourceAwsCloudSearchDomainRead,
+		Update: resourceAwsCloudSearchDomainUpdate,
+		Delete: resourceAwsCloudSearchDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_scheme": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"facet": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"highlight": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCloudSearchIndexFieldName,
+						},
+						"return": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"search": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sort": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"source_fields": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudsearch.IndexFieldTypeDate,
+								cloudsearch.IndexFieldTypeDateArray,
+								cloudsearch.IndexFieldTypeDouble,
+								cloudsearch.IndexFieldTypeDoubleArray,
+								cloudsearch.IndexFieldTypeInt,
+								cloudsearch.IndexFieldTypeIntArray,
+								cloudsearch.IndexFieldTypeLatlon,
+								cloudsearch.IndexFieldTypeLiteral,
+								cloudsearch.IndexFieldTypeLiteralArray,
+								cloudsearch.IndexFieldTypeText,
+								cloudsearch.IndexFieldTypeTextArray,
+							}, false),
+						},
+					},
+				},
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]{2,27}$`),
+					"must start with a lowercase letter, contain only lowercase letters, numbers and hyphens and be 3 to 28 characters long"),
+			},
+			"scaling_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_instance_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudsearch.PartitionInstanceTypeSearchM1Small,
+								cloudsearch.PartitionInstanceTypeSearchM1Large,
+								cloudsearch.PartitionInstanceTypeSearchM2Xlarge,
+								cloudsearch.PartitionInstanceTypeSearchM22xlarge,
+								cloudsearch.PartitionInstanceTypeSearchM3Medium,
+								cloudsearch.PartitionInstanceTypeSearchM3Large,
+								cloudsearch.PartitionInstanceTypeSearchM3Xlarge,
+								cloudsearch.PartitionInstanceTypeSearchM32xlarge,
+							}, false),
+						},
+						"desired_partition_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"desired_replication_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"search_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suggester": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fuzzy_matching": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  cloudsearch.SuggesterFuzzyMatchingNone,
+							ValidateFunc: validation.StringInSlice([]string{
+								cloudsearch.SuggesterFuzzyMatchingNone,
+								cloudsearch.SuggesterFuzzyMatchingLow,
+								cloudsearch.SuggesterFuzzyMatchingHigh,
+							}, false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9_]{2,63}$`),
+								"must start with a lowercase letter, contain only lowercase letters, numbers and underscores and be 3 to 64 characters long"),
+						},
+						"sort_expression": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_field": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Creating CloudSearch Domain: %s", name)
+	_, err := conn.CreateDomain(&cloudsearch.CreateDomainInput{
+		DomainName: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating CloudSearch Domain (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if v, ok := d.GetOk("scaling_parameters"); ok {
+		if err := updateCloudSearchDomainScalingParameters(conn, d.Id(), v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("multi_az").(bool) {
+		if err := updateCloudSearchDomainAvailabilityOptions(conn, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("access_policies"); ok {
+		if err := updateCloudSearchDomainAccessPolicies(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	for _, raw := range d.Get("index_field").(*schema.Set).List() {
+		if err := defineCloudSearchIndexField(conn, d.Id(), raw.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	for _, raw := range d.Get("suggester").(*schema.Set).List() {
+		if err := defineCloudSearchSuggester(conn, d.Id(), raw.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if err := waitForCloudSearchDomainActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudSearchDomainRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	domain, err := describeCloudSearchDomain(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if domain == nil || aws.BoolValue(domain.Deleted) {
+		log.Printf("[WARN] CloudSearch Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", domain.ARN)
+	d.Set("domain_id", domain.DomainId)
+	d.Set("name", domain.DomainName)
+
+	d.Set("document_service_endpoint", "")
+	if domain.DocService != nil {
+		d.Set("document_service_endpoint", domain.DocService.Endpoint)
+	}
+
+	d.Set("search_service_endpoint", "")
+	if domain.SearchService != nil {
+		d.Set("search_service_endpoint", domain.SearchService.Endpoint)
+	}
+
+	scalingResp, err := conn.DescribeScalingParameters(&cloudsearch.DescribeScalingParametersInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing CloudSearch Domain (%s) scaling parameters: %s", d.Id(), err)
+	}
+
+	if err := d.Set("scaling_parameters", flattenCloudSearchScalingParameters(scalingResp.ScalingParameters.Options)); err != nil {
+		return fmt.Errorf("error setting scaling_parameters: %s", err)
+	}
+
+	availabilityResp, err := conn.DescribeAvailabilityOptions(&cloudsearch.DescribeAvailabilityOptionsInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing CloudSearch Domain (%s) availability options: %s", d.Id(), err)
+	}
+
+	multiAz := false
+	if availabilityResp.AvailabilityOptions != nil {
+		multiAz = aws.BoolValue(availabilityResp.AvailabilityOptions.Options)
+	}
+	d.Set("multi_az", multiAz)
+
+	policiesResp, err := conn.DescribeServiceAccessPolicies(&cloudsearch.DescribeServiceAccessPoliciesInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing CloudSearch Domain (%s) access policies: %s", d.Id(), err)
+	}
+	d.Set("access_policies", policiesResp.AccessPolicies.Options)
+
+	indexResp, err := conn.DescribeIndexFields(&cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing CloudSearch Domain (%s) index fields: %s", d.Id(), err)
+	}
+
+	indexFields := make([]interface{}, 0, len(indexResp.IndexFields))
+	for _, status := range indexResp.IndexFields {
+		if status.Status != nil && aws.BoolValue(status.Status.PendingDeletion) {
+			continue
+		}
+		indexFields = append(indexFields, flattenCloudSearchIndexField(status.Options))
+	}
+
+	if err := d.Set("index_field", indexFields); err != nil {
+		return fmt.Errorf("error setting index_field: %s", err)
+	}
+
+	suggesterResp, err := conn.DescribeSuggesters(&cloudsearch.DescribeSuggestersInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing CloudSearch Domain (%s) suggesters: %s", d.Id(), err)
+	}
+
+	suggesters := make([]interface{}, 0, len(suggesterResp.Suggesters))
+	for _, status := range suggesterResp.Suggesters {
+		if status.Status != nil && aws.BoolValue(status.Status.PendingDeletion) {
+			continue
+		}
+		suggesters = append(suggesters, flattenCloudSearchSuggester(status.Options))
+	}
+
+	if err := d.Set("suggester", suggesters); err != nil {
+		return fmt.Errorf("error setting suggester: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudSearchDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	if d.HasChange("scaling_parameters") {
+		if err := updateCloudSearchDomainScalingParameters(conn, d.Id(), d.Get("scaling_parameters").([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("multi_az") {
+		if err := updateCloudSearchDomainAvailabilityOptions(conn, d.Id(), d.Get("multi_az").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("access_policies") {
+		if err := updateCloudSearchDomainAccessPolicies(conn, d.Id(), d.Get("access_policies").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("index_field") {
+		o, n := d.GetChange("index_field")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// Fields that are only changed are redefined in place, so only
+		// delete those whose names are no longer configured.
+		names := make(map[string]bool)
+		for _, raw := range ns.List() {
+			names[raw.(map[string]interface{})["name"].(string)] = true
+		}
+
+		for _, raw := range os.Difference(ns).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if names[name] {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting CloudSearch Domain (%s) index field: %s", d.Id(), name)
+			_, err := conn.DeleteIndexField(&cloudsearch.DeleteIndexFieldInput{
+				DomainName:     aws.String(d.Id()),
+				IndexFieldName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting CloudSearch Domain (%s) index field (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		for _, raw := range ns.Difference(os).List() {
+			if err := defineCloudSearchIndexField(conn, d.Id(), raw.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("suggester") {
+		o, n := d.GetChange("suggester")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		names := make(map[string]bool)
+		for _, raw := range ns.List() {
+			names[raw.(map[string]interface{})["name"].(string)] = true
+		}
+
+		for _, raw := range os.Difference(ns).List() {
+			name := raw.(map[string]interface{})["name"].(string)
+			if names[name] {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting CloudSearch Domain (%s) suggester: %s", d.Id(), name)
+			_, err := conn.DeleteSuggester(&cloudsearch.DeleteSuggesterInput{
+				DomainName:    aws.String(d.Id()),
+				SuggesterName: aws.String(name),
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting CloudSearch Domain (%s) suggester (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		for _, raw := range ns.Difference(os).List() {
+			if err := defineCloudSearchSuggester(conn, d.Id(), raw.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := waitForCloudSearchDomainActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudSearchDomainRead(d, meta)
+}
+
+func resourceAwsCloudSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudsearchconn
+
+	log.Printf("[DEBUG] Deleting CloudSearch Domain: %s", d.Id())
+	_, err := conn.DeleteDomain(&cloudsearch.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, cloudsearch.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting CloudSearch Domain (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudsearch.OptionStateActive,
+			cloudsearch.OptionStateProcessing,
+			cloudsearch.OptionStateRequiresIndexDocuments,
+		},
+		Target:     []string{"Deleted"},
+		Refresh:    cloudSearchDomainRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CloudSearch Domain (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeCloudSearchDomain(conn *cloudsearch.CloudSearch, name string) (*cloudsearch.DomainStatus, error) {
+	resp, err := conn.DescribeDomains(&cloudsearch.DescribeDomainsInput{
+		DomainNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing CloudSearch Domain (%s): %s", name, err)
+	}
+
+	for _, domain := range resp.DomainStatusList {
+		if aws.StringValue(domain.DomainName) == name {
+			return domain, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// cloudSearchDomainRefreshFunc reports a domain as Processing while its
+// configuration is being applied and as RequiresIndexDocuments when an index
+// field or suggester change has not yet been indexed.
+func cloudSearchDomainRefreshFunc(conn *cloudsearch.CloudSearch, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		domain, err := describeCloudSearchDomain(conn, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if domain == nil || aws.BoolValue(domain.Deleted) {
+			return "", "Deleted", nil
+		}
+
+		if aws.BoolValue(domain.Processing) {
+			return domain, cloudsearch.OptionStateProcessing, nil
+		}
+
+		if aws.BoolValue(domain.RequiresIndexDocuments) {
+			return domain, cloudsearch.OptionStateRequiresIndexDocuments, nil
+		}
+
+		return domain, cloudsearch.OptionStateActive, nil
+	}
+}
+
+// waitForCloudSearchDomainActive indexes the domain documents if the
+// configuration changes require it, then waits for processing to finish.
+func waitForCloudSearchDomainActive(conn *cloudsearch.CloudSearch, name string, timeout time.Duration) error {
+	domain, err := describeCloudSearchDomain(conn, name)
+	if err != nil {
+		return err
+	}
+
+	if domain != nil && aws.BoolValue(domain.RequiresIndexDocuments) {
+		log.Printf("[DEBUG] Indexing CloudSearch Domain documents: %s", name)
+		_, err := conn.IndexDocuments(&cloudsearch.IndexDocumentsInput{
+			DomainName: aws.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("error indexing CloudSearch Domain (%s) documents: %s", name, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudsearch.OptionStateProcessing,
+			cloudsearch.OptionStateRequiresIndexDocuments,
+		},
+		Target:     []string{cloudsearch.OptionStateActive},
+		Refresh:    cloudSearchDomainRefreshFunc(conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for CloudSearch Domain (%s) to become active: %s", name, err)
+	}
+
+	return nil
+}
+
+func updateCloudSearchDomainScalingParameters(conn *cloudsearch.CloudSearch, name string, l []interface{}) error {
+	input := &cloudsearch.UpdateScalingParametersInput{
+		DomainName:        aws.String(name),
+		ScalingParameters: expandCloudSearchScalingParameters(l),
+	}
+
+	log.Printf("[DEBUG] Updating CloudSearch Domain scaling parameters: %s", input)
+	if _, err := conn.UpdateScalingParameters(input); err != nil {
+		return fmt.Errorf("error updating CloudSearch Domain (%s) scaling parameters: %s", name, err)
+	}
+
+	return nil
+}
+
+func updateCloudSearchDomainAvailabilityOptions(conn *cloudsearch.CloudSearch, name string, multiAz bool) error {
+	input := &cloudsearch.UpdateAvailabilityOptionsInput{
+		DomainName: aws.String(name),
+		MultiAZ:    aws.Bool(multiAz),
+	}
+
+	log.Printf("[DEBUG] Updating CloudSearch Domain availability options: %s", input)
+	if _, err := conn.UpdateAvailabilityOptions(input); err != nil {
+		return fmt.Errorf("error updating CloudSearch Domain (%s) availability options: %s", name, err)
+	}
+
+	return nil
+}
+
+func updateCloudSearchDomainAccessPolicies(conn *cloudsearch.CloudSearch, name, policies string) error {
+	input := &cloudsearch.UpdateServiceAccessPoliciesInput{
+		DomainName:     aws.String(name),
+		AccessPolicies: aws.String(policies),
+	}
+
+	log.Printf("[DEBUG] Updating CloudSearch Domain access policies: %s", input)
+	if _, err := conn.UpdateServiceAccessPolicies(input); err != nil {
+		return fmt.Errorf("error updating CloudSearch Domain (%s) access policies: %s", name, err)
+	}
+
+	return nil
+}
+
+func defineCloudSearchIndexField(conn *cloudsearch.CloudSearch, name string, m map[string]interface{}) error {
+	indexField, err := expandCloudSearchIndexField(m)
+	if err != nil {
+		return err
+	}
+
+	input := &cloudsearch.DefineIndexFieldInput{
+		DomainName: aws.String(name),
+		IndexField: indexField,
+	}
+
+	log.Printf("[DEBUG] Defining CloudSearch Domain index field: %s", input)
+	if _, err := conn.DefineIndexField(input); err != nil {
+		return fmt.Errorf("error defining CloudSearch Domain (%s) index field (%s): %s", name, aws.StringValue(indexField.IndexFieldName), err)
+	}
+
+	return nil
+}
+
+func defineCloudSearchSuggester(conn *cloudsearch.CloudSearch, name string, m map[string]interface{}) error {
+	suggester := expandCloudSearchSuggester(m)
+
+	input := &cloudsearch.DefineSuggesterInput{
+		DomainName: aws.String(name),
+		Suggester:  suggester,
+	}
+
+	log.Printf("[DEBUG] Defining CloudSearch Domain suggester: %s", input)
+	if _, err := conn.DefineSuggester(input); err != nil {
+		return fmt.Errorf("error defining CloudSearch Domain (%s) suggester (%s): %s", name, aws.StringValue(suggester.SuggesterName), err)
+	}
+
+	return nil
+}
+
+func expandCloudSearchScalingParameters(l []interface{}) *cloudsearch.ScalingParameters {
+	scalingParameters := &cloudsearch.ScalingParameters{}
+
+	if len(l) == 0 || l[0] == nil {
+		return scalingParameters
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["desired_instance_type"].(string); ok && v != "" {
+		scalingParameters.DesiredInstanceType = aws.String(v)
+	}
+
+	if v, ok := m["desired_partition_count"].(int); ok && v > 0 {
+		scalingParameters.DesiredPartitionCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["desired_replication_count"].(int); ok && v > 0 {
+		scalingParameters.DesiredReplicationCount = aws.Int64(int64(v))
+	}
+
+	return scalingParameters
+}
+
+func flattenCloudSearchScalingParameters(scalingParameters *cloudsearch.ScalingParameters) []interface{} {
+	if scalingParameters == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"desired_instance_type":     aws.StringValue(scalingParameters.DesiredInstanceType),
+		"desired_partition_count":   int(aws.Int64Value(scalingParameters.DesiredPartitionCount)),
+		"desired_replication_count": int(aws.Int64Value(scalingParameters.DesiredReplicationCount)),
+	}
+
+	return []interface{}{m}
+}
+
+// expandCloudSearchIndexField builds the type-specific options for an index
+// field. Flags that do not apply to the field type are ignored.
+func expandCloudSearchIndexField(m map[string]interface{}) (*cloudsearch.IndexField, error) {
+	name := m["name"].(string)
+	fieldType := m["type"].(string)
+
+	indexField := &cloudsearch.IndexField{
+		IndexFieldName: aws.String(name),
+		IndexFieldType: aws.String(fieldType),
+	}
+
+	analysisScheme := m["analysis_scheme"].(string)
+	defaultValue := m["default_value"].(string)
+	facet := aws.Bool(m["facet"].(bool))
+	highlight := aws.Bool(m["highlight"].(bool))
+	returnEnabled := aws.Bool(m["return"].(bool))
+	search := aws.Bool(m["search"].(bool))
+	sort := aws.Bool(m["sort"].(bool))
+	sourceFields := m["source_fields"].(string)
+
+	switch fieldType {
+	case cloudsearch.IndexFieldTypeDate:
+		indexField.DateOptions = &cloudsearch.DateOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+		}
+		if defaultValue != "" {
+			indexField.DateOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.DateOptions.SourceField = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeDateArray:
+		indexField.DateArrayOptions = &cloudsearch.DateArrayOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+		}
+		if defaultValue != "" {
+			indexField.DateArrayOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.DateArrayOptions.SourceFields = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeDouble:
+		indexField.DoubleOptions = &cloudsearch.DoubleOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+		}
+		if defaultValue != "" {
+			v, err := strconv.ParseFloat(defaultValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("index field (%s) default_value must be a double: %s", name, err)
+			}
+			indexField.DoubleOptions.DefaultValue = aws.Float64(v)
+		}
+		if sourceFields != "" {
+			indexField.DoubleOptions.SourceField = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeDoubleArray:
+		indexField.DoubleArrayOptions = &cloudsearch.DoubleArrayOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+		}
+		if defaultValue != "" {
+			v, err := strconv.ParseFloat(defaultValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("index field (%s) default_value must be a double: %s", name, err)
+			}
+			indexField.DoubleArrayOptions.DefaultValue = aws.Float64(v)
+		}
+		if sourceFields != "" {
+			indexField.DoubleArrayOptions.SourceFields = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeInt:
+		indexField.IntOptions = &cloudsearch.IntOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+		}
+		if defaultValue != "" {
+			v, err := strconv.ParseInt(defaultValue, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("index field (%s) default_value must be an integer: %s", name, err)
+			}
+			indexField.IntOptions.DefaultValue = aws.Int64(v)
+		}
+		if sourceFields != "" {
+			indexField.IntOptions.SourceField = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeIntArray:
+		indexField.IntArrayOptions = &cloudsearch.IntArrayOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+		}
+		if defaultValue != "" {
+			v, err := strconv.ParseInt(defaultValue, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("index field (%s) default_value must be an integer: %s", name, err)
+			}
+			indexField.IntArrayOptions.DefaultValue = aws.Int64(v)
+		}
+		if sourceFields != "" {
+			indexField.IntArrayOptions.SourceFields = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeLatlon:
+		indexField.LatLonOptions = &cloudsearch.LatLonOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+		}
+		if defaultValue != "" {
+			indexField.LatLonOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.LatLonOptions.SourceField = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeLiteral:
+		indexField.LiteralOptions = &cloudsearch.LiteralOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+			SortEnabled:   sort,
+		}
+		if defaultValue != "" {
+			indexField.LiteralOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.LiteralOptions.SourceField = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeLiteralArray:
+		indexField.LiteralArrayOptions = &cloudsearch.LiteralArrayOptions{
+			FacetEnabled:  facet,
+			ReturnEnabled: returnEnabled,
+			SearchEnabled: search,
+		}
+		if defaultValue != "" {
+			indexField.LiteralArrayOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.LiteralArrayOptions.SourceFields = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeText:
+		indexField.TextOptions = &cloudsearch.TextOptions{
+			HighlightEnabled: highlight,
+			ReturnEnabled:    returnEnabled,
+			SortEnabled:      sort,
+		}
+		if analysisScheme != "" {
+			indexField.TextOptions.AnalysisScheme = aws.String(analysisScheme)
+		}
+		if defaultValue != "" {
+			indexField.TextOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.TextOptions.SourceField = aws.String(sourceFields)
+		}
+	case cloudsearch.IndexFieldTypeTextArray:
+		indexField.TextArrayOptions = &cloudsearch.TextArrayOptions{
+			HighlightEnabled: highlight,
+			ReturnEnabled:    returnEnabled,
+		}
+		if analysisScheme != "" {
+			indexField.TextArrayOptions.AnalysisScheme = aws.String(analysisScheme)
+		}
+		if defaultValue != "" {
+			indexField.TextArrayOptions.DefaultValue = aws.String(defaultValue)
+		}
+		if sourceFields != "" {
+			indexField.TextArrayOptions.SourceFields = aws.String(sourceFields)
+		}
+	default:
+		return nil, fmt.Errorf("index field (%s) has unsupported type: %s", name, fieldType)
+	}
+
+	return indexField, nil
+}
+
+func flattenCloudSearchIndexField(indexField *cloudsearch.IndexField) map[string]interface{} {
+	m := map[string]interface{}{
+		"analysis_scheme": "",
+		"default_value":   "",
+		"facet":           false,
+		"highlight":       false,
+		"name":            aws.StringValue(indexField.IndexFieldName),
+		"return":          false,
+		"search":          false,
+		"sort":            false,
+		"source_fields":   "",
+		"type":            aws.StringValue(indexField.IndexFieldType),
+	}
+
+	switch aws.StringValue(indexField.IndexFieldType) {
+	case cloudsearch.IndexFieldTypeDate:
+		if o := indexField.DateOptions; o != nil {
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		}
+	case cloudsearch.IndexFieldTypeDateArray:
+		if o := indexField.DateArrayOptions; o != nil {
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		}
+	case cloudsearch.IndexFieldTypeDouble:
+		if o := indexField.DoubleOptions; o != nil {
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatFloat(aws.Float64Value(o.DefaultValue), 'f', -1, 64)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		}
+	case cloudsearch.IndexFieldTypeDoubleArray:
+		if o := indexField.DoubleArrayOptions; o != nil {
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatFloat(aws.Float64Value(o.DefaultValue), 'f', -1, 64)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		}
+	case cloudsearch.IndexFieldTypeInt:
+		if o := indexField.IntOptions; o != nil {
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatInt(aws.Int64Value(o.DefaultValue), 10)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		}
+	case cloudsearch.IndexFieldTypeIntArray:
+		if o := indexField.IntArrayOptions; o != nil {
+			if o.DefaultValue != nil {
+				m["default_value"] = strconv.FormatInt(aws.Int64Value(o.DefaultValue), 10)
+			}
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		}
+	case cloudsearch.IndexFieldTypeLatlon:
+		if o := indexField.LatLonOptions; o != nil {
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		}
+	case cloudsearch.IndexFieldTypeLiteral:
+		if o := indexField.LiteralOptions; o != nil {
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		}
+	case cloudsearch.IndexFieldTypeLiteralArray:
+		if o := indexField.LiteralArrayOptions; o != nil {
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["facet"] = aws.BoolValue(o.FacetEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["search"] = aws.BoolValue(o.SearchEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		}
+	case cloudsearch.IndexFieldTypeText:
+		if o := indexField.TextOptions; o != nil {
+			m["analysis_scheme"] = aws.StringValue(o.AnalysisScheme)
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["highlight"] = aws.BoolValue(o.HighlightEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["sort"] = aws.BoolValue(o.SortEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceField)
+		}
+	case cloudsearch.IndexFieldTypeTextArray:
+		if o := indexField.TextArrayOptions; o != nil {
+			m["analysis_scheme"] = aws.StringValue(o.AnalysisScheme)
+			m["default_value"] = aws.StringValue(o.DefaultValue)
+			m["highlight"] = aws.BoolValue(o.HighlightEnabled)
+			m["return"] = aws.BoolValue(o.ReturnEnabled)
+			m["source_fields"] = aws.StringValue(o.SourceFields)
+		}
+	}
+
+	return m
+}
+
+func expandCloudSearchSuggester(m map[string]interface{}) *cloudsearch.Suggester {
+	options := &cloudsearch.DocumentSuggesterOptions{
+		FuzzyMatching: aws.String(m["fuzzy_matching"].(string)),
+		SourceField:   aws.String(m["source_field"].(string)),
+	}
+
+	if v, ok := m["sort_expression"].(string); ok && v != "" {
+		options.SortExpression = aws.String(v)
+	}
+
+	return &cloudsearch.Suggester{
+		DocumentSuggesterOptions: options,
+		SuggesterName:            aws.String(m["name"].(string)),
+	}
+}
+
+func flattenCloudSearchSuggester(suggester *cloudsearch.Suggester) map[string]interface{} {
+	m := map[string]interface{}{
+		"fuzzy_matching":  cloudsearch.SuggesterFuzzyMatchingNone,
+		"name":            aws.StringValue(suggester.SuggesterName),
+		"sort_expression": "",
+		"source_field":    "",
+	}
+
+	if o := suggester.DocumentSuggesterOptions; o != nil {
+		if o.FuzzyMatching != nil {
+			m["fuzzy_matching"] = aws.StringValue(o.FuzzyMatching)
+		}
+		m["sort_expression"] = aws.StringValue(o.SortExpression)
+		m["source_field"] = aws.StringValue(o.SourceField)
+	}
+
+	return m
+}
+
+func validateCloudSearchIndexFieldName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// Dynamic field patterns such as "*_i" or "i_*" start or end with a wildcard.
+	if !regexp.MustCompile(`^(\*[a-z0-9_]{1,63}|[a-z][a-z0-9_]{0,62}\*|[a-z][a-z0-9_]{2,63})$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must begin with a letter and contain only lowercase letters, numbers and underscores, be 3 to 64 characters long or be a dynamic field pattern with a leading or trailing wildcard: %q", k, value))
+	}
+
+	if value == "score" {
+		errors = append(errors, fmt.Errorf("%q cannot be %q, which is reserved", k, value))
+	}
+
+	return
+}
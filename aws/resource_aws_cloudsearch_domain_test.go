@@ -0,0 +1,363 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cloudsearch_domain", &resource.Sweeper{
+		Name: "aws_cloudsearch_domain",
+		F:    testSweepCloudSearchDomains,
+	})
+}
+
+func testSweepCloudSearchDomains(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).cloudsearchconn
+
+	resp, err := conn.ListDomainNames(&cloudsearch.ListDomainNamesInput{})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudSearch Domain sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving CloudSearch Domains: %s", err)
+	}
+
+	for name := range resp.DomainNames {
+		if !strings.HasPrefix(name, "tf-acc-test-") {
+			log.Printf("[INFO] Skipping CloudSearch Domain: %s", name)
+			continue
+		}
+
+		log.Printf("[INFO] Deleting CloudSearch Domain: %s", name)
+		_, err := conn.DeleteDomain(&cloudsearch.DeleteDomainInput{
+			DomainName: aws.String(name),
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete CloudSearch Domain (%s): %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+func TestValidateCloudSearchIndexFieldName(t *testing.T) {
+	validNames := []string{
+		"title",
+		"release_date",
+		"year2019",
+		"*_i",
+		"i_*",
+	}
+	for _, v := range validNames {
+		if _, errors := validateCloudSearchIndexFieldName(v, "name"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid index field name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"score",
+		"ab",
+		"Title",
+		"1title",
+		"*title*",
+		"title-text",
+		strings.Repeat("a", 65),
+	}
+	for _, v := range invalidNames {
+		if _, errors := validateCloudSearchIndexFieldName(v, "name"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid index field name", v)
+		}
+	}
+}
+
+func TestAccAWSCloudSearchDomain_basic(t *testing.T) {
+	var domain cloudsearch.DomainStatus
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")[:28]
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudSearch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &domain),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "cloudsearch", regexp.MustCompile(`domain/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "document_service_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_id"),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "multi_az", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_parameters.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "search_service_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "suggester.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudSearchDomain_IndexFields(t *testing.T) {
+	var domain cloudsearch.DomainStatus
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")[:28]
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudSearch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfigIndexFields(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "suggester.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudSearchDomainConfigIndexFieldsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "index_field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "suggester.#", "0"),
+					testAccCheckAWSCloudSearchDomainIndexed(&domain),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudSearchDomain_AccessPolicies(t *testing.T) {
+	var domain cloudsearch.DomainStatus
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")[:28]
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudSearch(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudSearchDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudSearchDomainConfigAccessPolicies(rName, "192.0.2.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &domain),
+					resource.TestMatchResourceAttr(resourceName, "access_policies", regexp.MustCompile(`192\.0\.2\.0/24`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudSearchDomainConfigAccessPolicies(rName, "198.51.100.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudSearchDomainExists(resourceName, &domain),
+					resource.TestMatchResourceAttr(resourceName, "access_policies", regexp.MustCompile(`198\.51\.100\.0/24`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudSearchDomainExists(n string, domain *cloudsearch.DomainStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudSearch Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+		resp, err := describeCloudSearchDomain(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || aws.BoolValue(resp.Deleted) {
+			return fmt.Errorf("CloudSearch Domain (%s) not found", rs.Primary.ID)
+		}
+
+		*domain = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudSearchDomainIndexed(domain *cloudsearch.DomainStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.BoolValue(domain.RequiresIndexDocuments) {
+			return fmt.Errorf("CloudSearch Domain (%s) requires indexing", aws.StringValue(domain.DomainName))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudSearchDomainDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudsearch_domain" {
+			continue
+		}
+
+		domain, err := describeCloudSearchDomain(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if domain != nil && !aws.BoolValue(domain.Deleted) {
+			return fmt.Errorf("CloudSearch Domain (%s) not deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccPreCheckAWSCloudSearch(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).cloudsearchconn
+
+	_, err := conn.ListDomainNames(&cloudsearch.ListDomainNamesInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSCloudSearchDomainConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudSearchDomainConfigIndexFields(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  index_field {
+    name            = "title"
+    type            = "text"
+    analysis_scheme = "_en_default_"
+    highlight       = true
+    return          = true
+    sort            = true
+  }
+
+  index_field {
+    name          = "year"
+    type          = "int"
+    default_value = "2000"
+    facet         = true
+    return        = true
+    search        = true
+    sort          = true
+  }
+
+  index_field {
+    name   = "genres"
+    type   = "literal-array"
+    facet  = true
+    search = true
+  }
+
+  suggester {
+    name           = "title_suggester"
+    source_field   = "title"
+    fuzzy_matching = "low"
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudSearchDomainConfigIndexFieldsUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  index_field {
+    name            = "title"
+    type            = "text"
+    analysis_scheme = "_en_default_"
+    highlight       = false
+    return          = true
+    sort            = true
+  }
+
+  index_field {
+    name          = "year"
+    type          = "int"
+    default_value = "2010"
+    facet         = true
+    return        = true
+    search        = true
+    sort          = true
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudSearchDomainConfigAccessPolicies(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudsearch_domain" "test" {
+  name = %[1]q
+
+  access_policies = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "cloudsearch:search",
+      "Condition": {
+        "IpAddress": {
+          "aws:SourceIp": %[2]q
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+`, rName, cidr)
+}
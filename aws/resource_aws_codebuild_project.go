@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCodeBuildProject() *schema.Resource {
@@ -501,7 +502,7 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.BadgeEnabled = aws.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
 
@@ -886,10 +887,22 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 
 	// The documentation clearly says "The replacement set of tags for this build project."
 	// But its a slice of pointers so if not set for every update, they get removed.
-	params.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
+	// Keep the tags matching the provider ignore_tags configuration as well.
+	resp, err := conn.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+		Names: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return fmt.Errorf("Error retreiving Projects: %q", err)
+	}
+
+	var remoteTags keyvaluetags.KeyValueTags
+	if len(resp.Projects) > 0 {
+		remoteTags = keyValueTagsCodeBuild(resp.Projects[0].Tags)
+	}
+	params.Tags = tagsFromKeyValueTagsCodeBuild(tagsMergeIgnored(meta, d.Get("tags_all").(map[string]interface{}), remoteTags))
 
 	// Handle IAM eventual consistency
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		var err error
 
 		_, err = conn.UpdateProject(params)
@@ -678,6 +678,37 @@ func TestAccAWSCodeBuildProject_Tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSCodeBuildProject_Tags_IgnoreTags(t *testing.T) {
+	var project codebuild.Project
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_codebuild_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCodeBuild(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeBuildProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeBuildProjectConfig_Tags_IgnoreTags(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName, &project),
+					testAccCheckAWSCodeBuildProjectAddTag(&project, "IgnoredKey", "external"),
+				),
+			},
+			{
+				Config: testAccAWSCodeBuildProjectConfig_Tags_IgnoreTags(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeBuildProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					testAccCheckAWSCodeBuildProjectTag(&project, "IgnoredKey", "external"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCodeBuildProject_VpcConfig(t *testing.T) {
 	var project codebuild.Project
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -869,6 +900,37 @@ func testAccCheckAWSCodeBuildProjectExists(n string, project *codebuild.Project)
 	}
 }
 
+// testAccCheckAWSCodeBuildProjectAddTag tags the project outside of Terraform.
+func testAccCheckAWSCodeBuildProjectAddTag(project *codebuild.Project, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+
+		tags := append(project.Tags, &codebuild.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+
+		_, err := conn.UpdateProject(&codebuild.UpdateProjectInput{
+			Name: project.Name,
+			Tags: tags,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSCodeBuildProjectTag(project *codebuild.Project, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tags := keyValueTagsCodeBuild(project.Tags).Map()
+
+		if v, ok := tags[key]; !ok || v != value {
+			return fmt.Errorf("CodeBuild Project (%s) tag %q: expected %q, got %q", aws.StringValue(project.Name), key, value, v)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSCodeBuildProjectDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
 
@@ -1546,6 +1608,41 @@ resource "aws_codebuild_project" "test" {
 `, rName, tagKey, tagValue)
 }
 
+func testAccAWSCodeBuildProjectConfig_Tags_IgnoreTags(rName, description string) string {
+	return `
+provider "aws" {
+  ignore_tags {
+    keys = ["IgnoredKey"]
+  }
+}
+` + testAccAWSCodeBuildProjectConfig_Base_ServiceRole(rName) + fmt.Sprintf(`
+resource "aws_codebuild_project" "test" {
+  description  = %[2]q
+  name         = %[1]q
+  service_role = "${aws_iam_role.test.arn}"
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "2"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type     = "GITHUB"
+    location = "https://github.com/hashicorp/packer.git"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, description)
+}
+
 func testAccAWSCodeBuildProjectConfig_VpcConfig(rName string, subnetCount int) string {
 	return testAccAWSCodeBuildProjectConfig_Base_ServiceRole(rName) + fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCognitoUserPool() *schema.Resource {
@@ -593,7 +594,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
@@ -838,15 +839,28 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
+	// UpdateUserPool replaces the whole tag set of the User Pool, so keep the
+	// tags matching the provider ignore_tags configuration.
+	resp, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading Cognito User Pool (%s): %s", d.Id(), err)
+	}
+
+	var remoteTags keyvaluetags.KeyValueTags
+	if resp.UserPool != nil {
+		remoteTags = keyvaluetags.New(resp.UserPool.UserPoolTags)
+	}
+	if tags := tagsMergeIgnored(meta, d.Get("tags_all").(map[string]interface{}), remoteTags); len(tags) > 0 {
+		params.UserPoolTags = tags
 	}
 
 	log.Printf("[DEBUG] Updating Cognito User Pool: %s", params)
 
 	// IAM roles & policies can take some time to propagate and be attached
 	// to the User Pool.
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
 		var err error
 		_, err = conn.UpdateUserPool(params)
 		if isAWSErr(err, "InvalidSmsRoleTrustRelationshipException", "Role does not have a trust relationship allowing Cognito to assume the role") {
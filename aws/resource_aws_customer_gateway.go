@@ -225,6 +225,8 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	return resourceAwsCustomerGatewayRead(d, meta)
 }
 
@@ -0,0 +1,586 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Pipeline states in which the pipeline has not been activated, or has been
+// deactivated, and so will not run.
+var dataPipelineInactiveStates = []string{
+	"DEACTIVATING",
+	"INACTIVE",
+	"PENDING",
+}
+
+func resourceAwsDataPipelinePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDataPipelinePipelineCreate,
+		Read:   resourceAwsDataPipelinePipelineRead,
+		Update: resourceAwsDataPipelinePipelineUpdate,
+		Delete: resourceAwsDataPipelinePipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsDataPipelinePipelineCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"pipeline_object": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"field": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"string_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"ref_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"parameter_object": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"attribute": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"string_value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"parameter_value": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"string_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"activate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datapipelineconn
+
+	input := &datapipeline.CreatePipelineInput{
+		Name:     aws.String(d.Get("name").(string)),
+		UniqueId: aws.String(resource.UniqueId()),
+		Tags:     tagsFromMapDataPipeline(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Data Pipeline: %s", input)
+	resp, err := conn.CreatePipeline(input)
+	if err != nil {
+		return fmt.Errorf("error creating Data Pipeline (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(resp.PipelineId))
+
+	if resourceAwsDataPipelinePipelineHasDefinition(d) {
+		if err := resourceAwsDataPipelinePipelinePutDefinition(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("activate").(bool) {
+		if err := resourceAwsDataPipelinePipelineActivate(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsDataPipelinePipelineRead(d, meta)
+}
+
+func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datapipelineconn
+
+	resp, err := conn.DescribePipelines(&datapipeline.DescribePipelinesInput{
+		PipelineIds: []*string{aws.String(d.Id())},
+	})
+	if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") {
+		log.Printf("[WARN] Data Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Data Pipeline (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.PipelineDescriptionList) == 0 || resp.PipelineDescriptionList[0] == nil {
+		log.Printf("[WARN] Data Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pipeline := resp.PipelineDescriptionList[0]
+	state := dataPipelineFieldValue(pipeline.Fields, "@pipelineState")
+
+	d.Set("name", pipeline.Name)
+	d.Set("description", pipeline.Description)
+	d.Set("state", state)
+	d.Set("activate", state != "" && !dataPipelineStateInactive(state))
+
+	if err := d.Set("tags", tagsToMapDataPipeline(pipeline.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	definition, err := conn.GetPipelineDefinition(&datapipeline.GetPipelineDefinitionInput{
+		PipelineId: aws.String(d.Id()),
+		Version:    aws.String("latest"),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting Data Pipeline (%s) definition: %s", d.Id(), err)
+	}
+
+	if err := d.Set("pipeline_object", flattenDataPipelinePipelineObjects(definition.PipelineObjects)); err != nil {
+		return fmt.Errorf("error setting pipeline_object: %s", err)
+	}
+
+	if err := d.Set("parameter_object", flattenDataPipelineParameterObjects(definition.ParameterObjects)); err != nil {
+		return fmt.Errorf("error setting parameter_object: %s", err)
+	}
+
+	if err := d.Set("parameter_value", flattenDataPipelineParameterValues(definition.ParameterValues)); err != nil {
+		return fmt.Errorf("error setting parameter_value: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataPipelinePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datapipelineconn
+
+	d.Partial(true)
+
+	definitionChanged := d.HasChange("pipeline_object") || d.HasChange("parameter_object") || d.HasChange("parameter_value")
+
+	if definitionChanged {
+		if err := resourceAwsDataPipelinePipelinePutDefinition(conn, d); err != nil {
+			return err
+		}
+
+		d.SetPartial("pipeline_object")
+		d.SetPartial("parameter_object")
+		d.SetPartial("parameter_value")
+	}
+
+	// Changes to the definition of an active pipeline only take effect once
+	// the pipeline is activated again.
+	if d.HasChange("activate") || (definitionChanged && d.Get("activate").(bool)) {
+		if d.Get("activate").(bool) {
+			if err := resourceAwsDataPipelinePipelineActivate(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			if err := resourceAwsDataPipelinePipelineDeactivate(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		d.SetPartial("activate")
+	}
+
+	if err := setTagsDataPipeline(conn, d); err != nil {
+		return fmt.Errorf("error updating Data Pipeline (%s) tags: %s", d.Id(), err)
+	}
+
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	d.Partial(false)
+
+	return resourceAwsDataPipelinePipelineRead(d, meta)
+}
+
+func resourceAwsDataPipelinePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).datapipelineconn
+
+	log.Printf("[DEBUG] Deleting Data Pipeline: %s", d.Id())
+	_, err := conn.DeletePipeline(&datapipeline.DeletePipelineInput{
+		PipelineId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Data Pipeline (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsDataPipelinePipelineCustomizeDiff validates changes to the
+// definition of an existing pipeline. ValidatePipelineDefinition requires the
+// ID of an existing pipeline, so the definition of a new pipeline is only
+// validated during apply, when it is first put.
+func resourceAwsDataPipelinePipelineCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	keys := []string{"pipeline_object", "parameter_object", "parameter_value"}
+
+	changed := false
+	for _, k := range keys {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+		if diff.HasChange(k) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).datapipelineconn
+
+	input := &datapipeline.ValidatePipelineDefinitionInput{
+		PipelineId:       aws.String(diff.Id()),
+		PipelineObjects:  expandDataPipelinePipelineObjects(diff.Get("pipeline_object").(*schema.Set).List()),
+		ParameterObjects: expandDataPipelineParameterObjects(diff.Get("parameter_object").(*schema.Set).List()),
+		ParameterValues:  expandDataPipelineParameterValues(diff.Get("parameter_value").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Validating Data Pipeline definition: %s", input)
+	resp, err := conn.ValidatePipelineDefinition(input)
+	if err != nil {
+		return fmt.Errorf("error validating Data Pipeline (%s) definition: %s", diff.Id(), err)
+	}
+
+	logDataPipelineValidationWarnings(diff.Id(), resp.ValidationWarnings)
+
+	if aws.BoolValue(resp.Errored) {
+		return fmt.Errorf("Data Pipeline (%s) definition is invalid:%s%s", diff.Id(), formatDataPipelineValidationErrors(resp.ValidationErrors), formatDataPipelineValidationWarnings(resp.ValidationWarnings))
+	}
+
+	return nil
+}
+
+func resourceAwsDataPipelinePipelineHasDefinition(d *schema.ResourceData) bool {
+	for _, k := range []string{"pipeline_object", "parameter_object", "parameter_value"} {
+		if d.Get(k).(*schema.Set).Len() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceAwsDataPipelinePipelinePutDefinition(conn *datapipeline.DataPipeline, d *schema.ResourceData) error {
+	input := &datapipeline.PutPipelineDefinitionInput{
+		PipelineId:       aws.String(d.Id()),
+		PipelineObjects:  expandDataPipelinePipelineObjects(d.Get("pipeline_object").(*schema.Set).List()),
+		ParameterObjects: expandDataPipelineParameterObjects(d.Get("parameter_object").(*schema.Set).List()),
+		ParameterValues:  expandDataPipelineParameterValues(d.Get("parameter_value").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Putting Data Pipeline definition: %s", input)
+	resp, err := conn.PutPipelineDefinition(input)
+	if err != nil {
+		return fmt.Errorf("error putting Data Pipeline (%s) definition: %s", d.Id(), err)
+	}
+
+	logDataPipelineValidationWarnings(d.Id(), resp.ValidationWarnings)
+
+	if aws.BoolValue(resp.Errored) {
+		return fmt.Errorf("Data Pipeline (%s) definition is invalid:%s%s", d.Id(), formatDataPipelineValidationErrors(resp.ValidationErrors), formatDataPipelineValidationWarnings(resp.ValidationWarnings))
+	}
+
+	return nil
+}
+
+func resourceAwsDataPipelinePipelineActivate(conn *datapipeline.DataPipeline, id string) error {
+	log.Printf("[DEBUG] Activating Data Pipeline: %s", id)
+	_, err := conn.ActivatePipeline(&datapipeline.ActivatePipelineInput{
+		PipelineId: aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("error activating Data Pipeline (%s): %s", id, err)
+	}
+
+	return nil
+}
+
+func resourceAwsDataPipelinePipelineDeactivate(conn *datapipeline.DataPipeline, id string) error {
+	log.Printf("[DEBUG] Deactivating Data Pipeline: %s", id)
+	_, err := conn.DeactivatePipeline(&datapipeline.DeactivatePipelineInput{
+		PipelineId: aws.String(id),
+	})
+	if err != nil {
+		return fmt.Errorf("error deactivating Data Pipeline (%s): %s", id, err)
+	}
+
+	return nil
+}
+
+func dataPipelineFieldValue(fields []*datapipeline.Field, key string) string {
+	for _, f := range fields {
+		if aws.StringValue(f.Key) == key {
+			return aws.StringValue(f.StringValue)
+		}
+	}
+
+	return ""
+}
+
+func dataPipelineStateInactive(state string) bool {
+	for _, s := range dataPipelineInactiveStates {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+func logDataPipelineValidationWarnings(id string, warnings []*datapipeline.ValidationWarning) {
+	for _, w := range warnings {
+		for _, msg := range w.Warnings {
+			log.Printf("[WARN] Data Pipeline (%s) object %q: %s", id, aws.StringValue(w.Id), aws.StringValue(msg))
+		}
+	}
+}
+
+func formatDataPipelineValidationErrors(errors []*datapipeline.ValidationError) string {
+	var buf bytes.Buffer
+	for _, e := range errors {
+		for _, msg := range e.Errors {
+			buf.WriteString(fmt.Sprintf("\n* object %q: %s", aws.StringValue(e.Id), aws.StringValue(msg)))
+		}
+	}
+
+	return buf.String()
+}
+
+func formatDataPipelineValidationWarnings(warnings []*datapipeline.ValidationWarning) string {
+	var buf bytes.Buffer
+	for _, w := range warnings {
+		for _, msg := range w.Warnings {
+			buf.WriteString(fmt.Sprintf("\n* object %q (warning): %s", aws.StringValue(w.Id), aws.StringValue(msg)))
+		}
+	}
+
+	return buf.String()
+}
+
+func expandDataPipelinePipelineObjects(l []interface{}) []*datapipeline.PipelineObject {
+	objects := make([]*datapipeline.PipelineObject, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		object := &datapipeline.PipelineObject{
+			Id:     aws.String(m["id"].(string)),
+			Name:   aws.String(m["name"].(string)),
+			Fields: []*datapipeline.Field{},
+		}
+
+		for _, rawField := range m["field"].(*schema.Set).List() {
+			f := rawField.(map[string]interface{})
+
+			field := &datapipeline.Field{
+				Key: aws.String(f["key"].(string)),
+			}
+
+			if v, ok := f["ref_value"].(string); ok && v != "" {
+				field.RefValue = aws.String(v)
+			} else {
+				field.StringValue = aws.String(f["string_value"].(string))
+			}
+
+			object.Fields = append(object.Fields, field)
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects
+}
+
+func flattenDataPipelinePipelineObjects(objects []*datapipeline.PipelineObject) []interface{} {
+	l := make([]interface{}, 0, len(objects))
+
+	for _, object := range objects {
+		fields := make([]interface{}, 0, len(object.Fields))
+		for _, field := range object.Fields {
+			fields = append(fields, map[string]interface{}{
+				"key":          aws.StringValue(field.Key),
+				"string_value": aws.StringValue(field.StringValue),
+				"ref_value":    aws.StringValue(field.RefValue),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"id":    aws.StringValue(object.Id),
+			"name":  aws.StringValue(object.Name),
+			"field": fields,
+		})
+	}
+
+	return l
+}
+
+func expandDataPipelineParameterObjects(l []interface{}) []*datapipeline.ParameterObject {
+	objects := make([]*datapipeline.ParameterObject, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		object := &datapipeline.ParameterObject{
+			Id:         aws.String(m["id"].(string)),
+			Attributes: []*datapipeline.ParameterAttribute{},
+		}
+
+		for _, rawAttribute := range m["attribute"].(*schema.Set).List() {
+			a := rawAttribute.(map[string]interface{})
+
+			object.Attributes = append(object.Attributes, &datapipeline.ParameterAttribute{
+				Key:         aws.String(a["key"].(string)),
+				StringValue: aws.String(a["string_value"].(string)),
+			})
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects
+}
+
+func flattenDataPipelineParameterObjects(objects []*datapipeline.ParameterObject) []interface{} {
+	l := make([]interface{}, 0, len(objects))
+
+	for _, object := range objects {
+		attributes := make([]interface{}, 0, len(object.Attributes))
+		for _, attribute := range object.Attributes {
+			attributes = append(attributes, map[string]interface{}{
+				"key":          aws.StringValue(attribute.Key),
+				"string_value": aws.StringValue(attribute.StringValue),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"id":        aws.StringValue(object.Id),
+			"attribute": attributes,
+		})
+	}
+
+	return l
+}
+
+func expandDataPipelineParameterValues(l []interface{}) []*datapipeline.ParameterValue {
+	values := make([]*datapipeline.ParameterValue, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		values = append(values, &datapipeline.ParameterValue{
+			Id:          aws.String(m["id"].(string)),
+			StringValue: aws.String(m["string_value"].(string)),
+		})
+	}
+
+	return values
+}
+
+func flattenDataPipelineParameterValues(values []*datapipeline.ParameterValue) []interface{} {
+	l := make([]interface{}, 0, len(values))
+
+	for _, value := range values {
+		l = append(l, map[string]interface{}{
+			"id":           aws.StringValue(value.Id),
+			"string_value": aws.StringValue(value.StringValue),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,732 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_datapipeline_pipeline", &resource.Sweeper{
+		Name: "aws_datapipeline_pipeline",
+		F:    testSweepDataPipelinePipelines,
+	})
+}
+
+func testSweepDataPipelinePipelines(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).datapipelineconn
+
+	err = conn.ListPipelinesPages(&datapipeline.ListPipelinesInput{}, func(page *datapipeline.ListPipelinesOutput, lastPage bool) bool {
+		for _, pipeline := range page.PipelineIdList {
+			name := aws.StringValue(pipeline.Name)
+			if !strings.HasPrefix(name, "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Data Pipeline: %s", name)
+				continue
+			}
+
+			id := aws.StringValue(pipeline.Id)
+			log.Printf("[INFO] Deleting Data Pipeline: %s (%s)", name, id)
+			_, err := conn.DeletePipeline(&datapipeline.DeletePipelineInput{
+				PipelineId: aws.String(id),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Data Pipeline (%s): %s", id, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Data Pipeline sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Pipelines: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSDataPipelinePipeline_basic(t *testing.T) {
+	var pipeline datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "PENDING"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_object.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_object.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_value.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataPipelinePipeline_disappears(t *testing.T) {
+	var pipeline datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline),
+					testAccCheckAWSDataPipelinePipelineDisappears(&pipeline),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataPipelinePipeline_Description(t *testing.T) {
+	var pipeline datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfigDescription(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataPipelinePipeline_Definition(t *testing.T) {
+	var pipeline1, pipeline2 datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline1),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_object.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSDataPipelinePipelineConfigParameters(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline2),
+					testAccCheckAWSDataPipelinePipelineNotRecreated(&pipeline1, &pipeline2),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_object.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_object.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_value.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDataPipelinePipeline_InvalidDefinition(t *testing.T) {
+	var pipeline datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline),
+				),
+			},
+			{
+				Config:      testAccAWSDataPipelinePipelineConfigInvalidDefinition(rName),
+				ExpectError: regexp.MustCompile(`definition is invalid`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataPipelinePipeline_Activate(t *testing.T) {
+	var pipeline1, pipeline2, pipeline3 datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfigActivate(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline1),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "PENDING"),
+				),
+			},
+			{
+				Config: testAccAWSDataPipelinePipelineConfigActivate(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline2),
+					testAccCheckAWSDataPipelinePipelineNotRecreated(&pipeline1, &pipeline2),
+					resource.TestCheckResourceAttr(resourceName, "activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "SCHEDULED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataPipelinePipelineConfigActivate(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline3),
+					testAccCheckAWSDataPipelinePipelineNotRecreated(&pipeline2, &pipeline3),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataPipelinePipeline_Tags(t *testing.T) {
+	var pipeline1, pipeline2, pipeline3 datapipeline.PipelineDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_datapipeline_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSDataPipeline(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDataPipelinePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataPipelinePipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDataPipelinePipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline2),
+					testAccCheckAWSDataPipelinePipelineNotRecreated(&pipeline1, &pipeline2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSDataPipelinePipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDataPipelinePipelineExists(resourceName, &pipeline3),
+					testAccCheckAWSDataPipelinePipelineNotRecreated(&pipeline2, &pipeline3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDataPipelinePipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_datapipeline_pipeline" {
+			continue
+		}
+
+		output, err := conn.DescribePipelines(&datapipeline.DescribePipelinesInput{
+			PipelineIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.PipelineDescriptionList) > 0 {
+			return fmt.Errorf("Data Pipeline %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDataPipelinePipelineExists(resourceName string, pipeline *datapipeline.PipelineDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Data Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+
+		output, err := conn.DescribePipelines(&datapipeline.DescribePipelinesInput{
+			PipelineIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.PipelineDescriptionList) == 0 || output.PipelineDescriptionList[0] == nil {
+			return fmt.Errorf("Data Pipeline %q does not exist", rs.Primary.ID)
+		}
+
+		*pipeline = *output.PipelineDescriptionList[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDataPipelinePipelineDisappears(pipeline *datapipeline.PipelineDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+
+		_, err := conn.DeletePipeline(&datapipeline.DeletePipelineInput{
+			PipelineId: pipeline.PipelineId,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSDataPipelinePipelineNotRecreated(i, j *datapipeline.PipelineDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.PipelineId) != aws.StringValue(j.PipelineId) {
+			return errors.New("Data Pipeline was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSDataPipeline(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).datapipelineconn
+
+	_, err := conn.ListPipelines(&datapipeline.ListPipelinesInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAWSDataPipelinePipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "datapipeline.amazonaws.com",
+          "elasticmapreduce.amazonaws.com"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSDataPipelineRole"
+}
+
+resource "aws_iam_role" "resource" {
+  name = "%[1]s-resource"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "resource" {
+  role       = "${aws_iam_role.resource.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonEC2RoleforDataPipelineRole"
+}
+
+resource "aws_iam_instance_profile" "resource" {
+  name = "${aws_iam_role.resource.name}"
+  role = "${aws_iam_role.resource.name}"
+}
+`, rName)
+}
+
+func testAccAWSDataPipelinePipelineConfig(rName string) string {
+	return testAccAWSDataPipelinePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name = %[1]q
+
+  pipeline_object {
+    id   = "Default"
+    name = "Default"
+
+    field {
+      key          = "scheduleType"
+      string_value = "ondemand"
+    }
+
+    field {
+      key          = "failureAndRerunMode"
+      string_value = "CASCADE"
+    }
+
+    field {
+      key          = "role"
+      string_value = "${aws_iam_role.test.name}"
+    }
+
+    field {
+      key          = "resourceRole"
+      string_value = "${aws_iam_instance_profile.resource.name}"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSDataPipelinePipelineConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccAWSDataPipelinePipelineConfigParameters(rName, command string) string {
+	return testAccAWSDataPipelinePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name = %[1]q
+
+  pipeline_object {
+    id   = "Default"
+    name = "Default"
+
+    field {
+      key          = "scheduleType"
+      string_value = "ondemand"
+    }
+
+    field {
+      key          = "failureAndRerunMode"
+      string_value = "CASCADE"
+    }
+
+    field {
+      key          = "role"
+      string_value = "${aws_iam_role.test.name}"
+    }
+
+    field {
+      key          = "resourceRole"
+      string_value = "${aws_iam_instance_profile.resource.name}"
+    }
+  }
+
+  pipeline_object {
+    id   = "Ec2Instance"
+    name = "Ec2Instance"
+
+    field {
+      key          = "type"
+      string_value = "Ec2Resource"
+    }
+
+    field {
+      key          = "terminateAfter"
+      string_value = "10 Minutes"
+    }
+  }
+
+  pipeline_object {
+    id   = "ShellCommandActivity"
+    name = "ShellCommandActivity"
+
+    field {
+      key          = "type"
+      string_value = "ShellCommandActivity"
+    }
+
+    field {
+      key          = "command"
+      string_value = "#{myShellCmd}"
+    }
+
+    field {
+      key       = "runsOn"
+      ref_value = "Ec2Instance"
+    }
+  }
+
+  parameter_object {
+    id = "myShellCmd"
+
+    attribute {
+      key          = "type"
+      string_value = "String"
+    }
+
+    attribute {
+      key          = "description"
+      string_value = "Shell command to run"
+    }
+  }
+
+  parameter_value {
+    id           = "myShellCmd"
+    string_value = %[2]q
+  }
+}
+`, rName, command)
+}
+
+func testAccAWSDataPipelinePipelineConfigInvalidDefinition(rName string) string {
+	return testAccAWSDataPipelinePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name = %[1]q
+
+  pipeline_object {
+    id   = "Default"
+    name = "Default"
+
+    field {
+      key          = "scheduleType"
+      string_value = "ondemand"
+    }
+  }
+
+  pipeline_object {
+    id   = "ShellCommandActivity"
+    name = "ShellCommandActivity"
+
+    field {
+      key          = "type"
+      string_value = "ShellCommandActivity"
+    }
+
+    field {
+      key       = "runsOn"
+      ref_value = "DoesNotExist"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSDataPipelinePipelineConfigActivate(rName string, activate bool) string {
+	return testAccAWSDataPipelinePipelineConfigBase(rName) + fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name     = %[1]q
+  activate = %[2]t
+
+  pipeline_object {
+    id   = "Default"
+    name = "Default"
+
+    field {
+      key          = "scheduleType"
+      string_value = "cron"
+    }
+
+    field {
+      key       = "schedule"
+      ref_value = "DefaultSchedule"
+    }
+
+    field {
+      key          = "failureAndRerunMode"
+      string_value = "CASCADE"
+    }
+
+    field {
+      key          = "role"
+      string_value = "${aws_iam_role.test.name}"
+    }
+
+    field {
+      key          = "resourceRole"
+      string_value = "${aws_iam_instance_profile.resource.name}"
+    }
+  }
+
+  pipeline_object {
+    id   = "DefaultSchedule"
+    name = "Every 1 day"
+
+    field {
+      key          = "type"
+      string_value = "Schedule"
+    }
+
+    field {
+      key          = "period"
+      string_value = "1 day"
+    }
+
+    field {
+      key          = "startDateTime"
+      string_value = "2030-01-01T00:00:00"
+    }
+  }
+
+  pipeline_object {
+    id   = "Ec2Instance"
+    name = "Ec2Instance"
+
+    field {
+      key          = "type"
+      string_value = "Ec2Resource"
+    }
+
+    field {
+      key          = "terminateAfter"
+      string_value = "10 Minutes"
+    }
+  }
+
+  pipeline_object {
+    id   = "ShellCommandActivity"
+    name = "ShellCommandActivity"
+
+    field {
+      key          = "type"
+      string_value = "ShellCommandActivity"
+    }
+
+    field {
+      key          = "command"
+      string_value = "echo hello"
+    }
+
+    field {
+      key       = "runsOn"
+      ref_value = "Ec2Instance"
+    }
+  }
+}
+`, rName, activate)
+}
+
+func testAccAWSDataPipelinePipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSDataPipelinePipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_datapipeline_pipeline" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
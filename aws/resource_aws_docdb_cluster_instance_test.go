@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+		Name: "aws_docdb_cluster_instance",
+		F:    testSweepDocDBClusterInstances,
+	})
+}
+
+func testSweepDocDBClusterInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).docdbconn
+
+	input := &docdb.DescribeDBInstancesInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: aws.StringSlice([]string{"docdb"}),
+			},
+		},
+	}
+	err = conn.DescribeDBInstancesPages(input, func(page *docdb.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, dbi := range page.DBInstances {
+			id := aws.StringValue(dbi.DBInstanceIdentifier)
+
+			if !strings.HasPrefix(id, "tf-") {
+				log.Printf("[INFO] Skipping DocDB Cluster Instance: %s", id)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting DocDB Cluster Instance: %s", id)
+			_, err := conn.DeleteDBInstance(&docdb.DeleteDBInstanceInput{
+				DBInstanceIdentifier: aws.String(id),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete DocDB Cluster Instance %s: %s", id, err)
+				continue
+			}
+
+			err = conn.WaitUntilDBInstanceDeleted(&docdb.DescribeDBInstancesInput{
+				DBInstanceIdentifier: aws.String(id),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to wait for DocDB Cluster Instance %s deletion: %s", id, err)
+			}
+		}
+
+		return !lastPage
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping DocDB Cluster Instance sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving DocDB Cluster Instances: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSDocDBClusterInstance_basic(t *testing.T) {
+	var v docdb.DBInstance
+	rInt := acctest.RandInt()
+	resourceName := "aws_docdb_cluster_instance.cluster_instances"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterInstanceConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "rds", fmt.Sprintf("db:tf-cluster-instance-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "true"),
+					resource.TestMatchResourceAttr(resourceName, "availability_zone", regexp.MustCompile(fmt.Sprintf("^%s", testAccGetRegion()))),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_docdb_cluster.default", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "dbi_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "docdb"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", fmt.Sprintf("tf-cluster-instance-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r4.large"),
+					resource.TestCheckResourceAttr(resourceName, "port", "27017"),
+					resource.TestCheckResourceAttr(resourceName, "promotion_tier", "3"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "writer", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+			{
+				Config: testAccAWSDocDBClusterInstanceConfigModified(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r4.xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBClusterInstance_namePrefix(t *testing.T) {
+	var v docdb.DBInstance
+	rInt := acctest.RandInt()
+	resourceName := "aws_docdb_cluster_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterInstanceConfig_namePrefix(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "identifier", regexp.MustCompile("^tf-cluster-instance-")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBClusterInstance_kmsKey(t *testing.T) {
+	var v docdb.DBInstance
+	resourceName := "aws_docdb_cluster_instance.cluster_instances"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterInstanceConfigKmsKey(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterInstanceExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.foo", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDocDBClusterInstanceExists(n string, v *docdb.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DocDB Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+		resp, err := conn.DescribeDBInstances(&docdb.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, d := range resp.DBInstances {
+			if aws.StringValue(d.DBInstanceIdentifier) == rs.Primary.ID {
+				*v = *d
+				return nil
+			}
+		}
+
+		return fmt.Errorf("DocDB Cluster Instance (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccAWSDocDBClusterInstanceConfig(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier  = "tf-docdb-cluster-test-%[1]d"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_docdb_cluster_instance" "cluster_instances" {
+  identifier         = "tf-cluster-instance-%[1]d"
+  cluster_identifier = "${aws_docdb_cluster.default.id}"
+  instance_class     = "db.r4.large"
+  promotion_tier     = "3"
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterInstanceConfigModified(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier  = "tf-docdb-cluster-test-%[1]d"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_docdb_cluster_instance" "cluster_instances" {
+  identifier                 = "tf-cluster-instance-%[1]d"
+  cluster_identifier         = "${aws_docdb_cluster.default.id}"
+  instance_class             = "db.r4.xlarge"
+  auto_minor_version_upgrade = false
+  promotion_tier             = "3"
+  apply_immediately          = true
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterInstanceConfig_namePrefix(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = "tf-docdb-cluster-%[1]d"
+  master_username     = "root"
+  master_password     = "password"
+  skip_final_snapshot = true
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  identifier_prefix  = "tf-cluster-instance-"
+  cluster_identifier = "${aws_docdb_cluster.test.id}"
+  instance_class     = "db.r4.large"
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterInstanceConfigKmsKey(n int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "foo" {
+  description = "Terraform acc test %[1]d"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier  = "tf-docdb-cluster-test-%[1]d"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  storage_encrypted   = true
+  kms_key_id          = "${aws_kms_key.foo.arn}"
+  skip_final_snapshot = true
+}
+
+resource "aws_docdb_cluster_instance" "cluster_instances" {
+  identifier         = "tf-cluster-instance-%[1]d"
+  cluster_identifier = "${aws_docdb_cluster.default.id}"
+  instance_class     = "db.r4.large"
+}
+`, n)
+}
@@ -0,0 +1,569 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_docdb_cluster", &resource.Sweeper{
+		Name: "aws_docdb_cluster",
+		F:    testSweepDocDBClusters,
+		Dependencies: []string{
+			"aws_docdb_cluster_instance",
+		},
+	})
+}
+
+func testSweepDocDBClusters(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).docdbconn
+
+	input := &docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: aws.StringSlice([]string{"docdb"}),
+			},
+		},
+	}
+	err = conn.DescribeDBClustersPages(input, func(page *docdb.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, dbc := range page.DBClusters {
+			id := aws.StringValue(dbc.DBClusterIdentifier)
+
+			if !strings.HasPrefix(id, "tf-") {
+				log.Printf("[INFO] Skipping DocDB Cluster: %s", id)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting DocDB Cluster: %s", id)
+			_, err := conn.DeleteDBCluster(&docdb.DeleteDBClusterInput{
+				DBClusterIdentifier: aws.String(id),
+				SkipFinalSnapshot:   aws.Bool(true),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete DocDB Cluster %s: %s", id, err)
+			}
+		}
+
+		return !lastPage
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping DocDB Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving DocDB Clusters: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSDocDBCluster_basic(t *testing.T) {
+	var dbCluster docdb.DBCluster
+	rInt := acctest.RandInt()
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &dbCluster),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "rds", fmt.Sprintf("cluster:tf-docdb-cluster-%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "db_cluster_parameter_group_name", "default.docdb3.6"),
+					resource.TestCheckResourceAttrSet(resourceName, "reader_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_resource_id"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "docdb"),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "hosted_zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "port", "27017"),
+					resource.TestCheckResourceAttr(resourceName, "master_username", "foo"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_namePrefix(t *testing.T) {
+	var v docdb.DBCluster
+	resourceName := "aws_docdb_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_namePrefix(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "cluster_identifier", regexp.MustCompile("^tf-test-")),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_identifier_prefix", "master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_takeFinalSnapshot(t *testing.T) {
+	var v docdb.DBCluster
+	rInt := acctest.RandInt()
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterSnapshot(rInt),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfigWithFinalSnapshot(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"final_snapshot_identifier", "master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_updateTags(t *testing.T) {
+	var v docdb.DBCluster
+	ri := acctest.RandInt()
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterConfigUpdatedTags(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_kmsKey(t *testing.T) {
+	var v docdb.DBCluster
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_kmsKey(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.foo", "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_encrypted(t *testing.T) {
+	var v docdb.DBCluster
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_encrypted(acctest.RandInt()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_backupsUpdate(t *testing.T) {
+	var v docdb.DBCluster
+	ri := acctest.RandInt()
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig_backups(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "07:00-09:00"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "5"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_maintenance_window", "tue:04:00-tue:04:30"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterConfig_backupsUpdate(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preferred_backup_window", "03:00-09:00"),
+					resource.TestCheckResourceAttr(resourceName, "backup_retention_period", "10"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_maintenance_window", "wed:01:00-wed:01:30"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func TestAccAWSDocDBCluster_enabledCloudwatchLogsExports(t *testing.T) {
+	var v docdb.DBCluster
+	ri := acctest.RandInt()
+	resourceName := "aws_docdb_cluster.default"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDocDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDocDBClusterConfig(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSDocDBClusterConfig_enabledCloudwatchLogsExports(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDocDBClusterExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled_cloudwatch_logs_exports.0", "audit"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "master_password", "skip_final_snapshot"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDocDBClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_docdb_cluster" {
+			continue
+		}
+
+		resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, docdb.ErrCodeDBClusterNotFoundFault, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(resp.DBClusters) != 0 &&
+			aws.StringValue(resp.DBClusters[0].DBClusterIdentifier) == rs.Primary.ID {
+			return fmt.Errorf("DocDB Cluster %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDocDBClusterExists(n string, v *docdb.DBCluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DocDB Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).docdbconn
+		resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range resp.DBClusters {
+			if aws.StringValue(c.DBClusterIdentifier) == rs.Primary.ID {
+				*v = *c
+				return nil
+			}
+		}
+
+		return fmt.Errorf("DocDB Cluster (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSDocDBClusterSnapshot(rInt int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_docdb_cluster" {
+				continue
+			}
+
+			// Try and delete the snapshot before we check for the cluster not found
+			snapshotIdentifier := fmt.Sprintf("tf-acctest-docdbcluster-snapshot-%d", rInt)
+
+			conn := testAccProvider.Meta().(*AWSClient).docdbconn
+
+			log.Printf("[INFO] Deleting the Snapshot %s", snapshotIdentifier)
+			_, err := conn.DeleteDBClusterSnapshot(&docdb.DeleteDBClusterSnapshotInput{
+				DBClusterSnapshotIdentifier: aws.String(snapshotIdentifier),
+			})
+			if err != nil {
+				return err
+			}
+
+			resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
+				DBClusterIdentifier: aws.String(rs.Primary.ID),
+			})
+			if isAWSErr(err, docdb.ErrCodeDBClusterNotFoundFault, "") {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(resp.DBClusters) != 0 &&
+				aws.StringValue(resp.DBClusters[0].DBClusterIdentifier) == rs.Primary.ID {
+				return fmt.Errorf("DocDB Cluster %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDocDBClusterConfig(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier              = "tf-docdb-cluster-%d"
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharaters"
+  db_cluster_parameter_group_name = "default.docdb3.6"
+  skip_final_snapshot             = true
+
+  tags = {
+    Environment = "production"
+  }
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfig_namePrefix() string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier_prefix = "tf-test-"
+  master_username           = "root"
+  master_password           = "password"
+  skip_final_snapshot       = true
+}
+`)
+}
+
+func testAccAWSDocDBClusterConfigWithFinalSnapshot(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier        = "tf-docdb-cluster-%[1]d"
+  master_username           = "foo"
+  master_password           = "mustbeeightcharaters"
+  final_snapshot_identifier = "tf-acctest-docdbcluster-snapshot-%[1]d"
+
+  tags = {
+    Environment = "production"
+  }
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfigUpdatedTags(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier              = "tf-docdb-cluster-%d"
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharaters"
+  db_cluster_parameter_group_name = "default.docdb3.6"
+  skip_final_snapshot             = true
+
+  tags = {
+    Environment = "production"
+    AnotherTag  = "test"
+  }
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfig_kmsKey(n int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "foo" {
+  description = "Terraform acc test %[1]d"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Id": "kms-tf-1",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {
+        "AWS": "*"
+      },
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier  = "tf-docdb-cluster-%[1]d"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  storage_encrypted   = true
+  kms_key_id          = "${aws_kms_key.foo.arn}"
+  skip_final_snapshot = true
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfig_encrypted(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier  = "tf-docdb-cluster-%d"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  storage_encrypted   = true
+  skip_final_snapshot = true
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfig_backups(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier           = "tf-docdb-cluster-%d"
+  master_username              = "foo"
+  master_password              = "mustbeeightcharaters"
+  backup_retention_period      = 5
+  preferred_backup_window      = "07:00-09:00"
+  preferred_maintenance_window = "tue:04:00-tue:04:30"
+  skip_final_snapshot          = true
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfig_backupsUpdate(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier           = "tf-docdb-cluster-%d"
+  master_username              = "foo"
+  master_password              = "mustbeeightcharaters"
+  backup_retention_period      = 10
+  preferred_backup_window      = "03:00-09:00"
+  preferred_maintenance_window = "wed:01:00-wed:01:30"
+  apply_immediately            = true
+  skip_final_snapshot          = true
+}
+`, n)
+}
+
+func testAccAWSDocDBClusterConfig_enabledCloudwatchLogsExports(n int) string {
+	return fmt.Sprintf(`
+resource "aws_docdb_cluster" "default" {
+  cluster_identifier              = "tf-docdb-cluster-%d"
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharaters"
+  db_cluster_parameter_group_name = "default.docdb3.6"
+  enabled_cloudwatch_logs_exports = ["audit"]
+  apply_immediately               = true
+  skip_final_snapshot             = true
+
+  tags = {
+    Environment = "production"
+  }
+}
+`, n)
+}
@@ -66,7 +66,7 @@ func resourceAwsDocDBSubnetGroup() *schema.Resource {
 
 func resourceAwsDocDBSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).docdbconn
-	tags := tagsFromMapDocDB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapDocDB(d.Get("tags_all").(map[string]interface{}))
 
 	subnetIds := expandStringSet(d.Get("subnet_ids").(*schema.Set))
 
@@ -174,6 +174,7 @@ func resourceAwsDocDBSubnetGroupUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error setting DocDB Subnet Group (%s) tags: %s", d.Id(), err)
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsDocDBSubnetGroupRead(d, meta)
 }
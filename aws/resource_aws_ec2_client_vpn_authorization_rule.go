@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ClientVpnAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnAuthorizationRuleCreate,
+		Read:   resourceAwsEc2ClientVpnAuthorizationRuleRead,
+		Delete: resourceAwsEc2ClientVpnAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"authorize_all_groups"},
+			},
+			"authorize_all_groups": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"client_vpn_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_network_cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetNetworkCidr := d.Get("target_network_cidr").(string)
+	accessGroupID := d.Get("access_group_id").(string)
+
+	if accessGroupID == "" && !d.Get("authorize_all_groups").(bool) {
+		return fmt.Errorf("one of access_group_id or authorize_all_groups must be set")
+	}
+
+	input := &ec2.AuthorizeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		input.AuthorizeAllGroups = aws.Bool(true)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Client VPN Authorization Rule: %s", input)
+	_, err := conn.AuthorizeClientVpnIngress(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Client VPN Authorization Rule: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_%s", clientVpnEndpointID, targetNetworkCidr, accessGroupID))
+
+	if err := waitForEc2ClientVpnAuthorizationRuleCreation(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Authorization Rule (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnAuthorizationRuleRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) not found, removing from state", clientVpnEndpointID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Authorization Rule: %s", err)
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] EC2 Client VPN Authorization Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var status string
+	if rule.Status != nil {
+		status = aws.StringValue(rule.Status.Code)
+	}
+
+	if status == ec2.ClientVpnAuthorizationRuleStatusCodeRevoking {
+		log.Printf("[WARN] EC2 Client VPN Authorization Rule (%s) revoking, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("access_group_id", rule.GroupId)
+	d.Set("authorize_all_groups", rule.AccessAll)
+	d.Set("client_vpn_endpoint_id", rule.ClientVpnEndpointId)
+	d.Set("description", rule.Description)
+	d.Set("status", status)
+	d.Set("target_network_cidr", rule.DestinationCidr)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.RevokeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		input.RevokeAllGroups = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Client VPN Authorization Rule (%s): %s", d.Id(), input)
+	_, err = conn.RevokeClientVpnIngress(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnEndpointAuthorizationRuleNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Client VPN Authorization Rule: %s", err)
+	}
+
+	if err := waitForEc2ClientVpnAuthorizationRuleDeletion(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Authorization Rule (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
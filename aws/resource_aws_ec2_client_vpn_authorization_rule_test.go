@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2ClientVpnAuthorizationRule_basic(t *testing.T) {
+	var rule ec2.AuthorizationRule
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnAuthorizationRuleConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnAuthorizationRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", "aws_ec2_client_vpn_endpoint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "example authorization rule"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.ClientVpnAuthorizationRuleStatusCodeActive),
+					resource.TestCheckResourceAttrPair(resourceName, "target_network_cidr", "aws_subnet.test", "cidr_block"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2ClientVpnAuthorizationRuleExists(resourceName string, authorizationRule *ec2.AuthorizationRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Client VPN Authorization Rule ID is set")
+		}
+
+		clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+		if err != nil {
+			return err
+		}
+
+		if rule == nil {
+			return fmt.Errorf("EC2 Client VPN Authorization Rule not found")
+		}
+
+		*authorizationRule = *rule
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2ClientVpnAuthorizationRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
+			continue
+		}
+
+		clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("EC2 Client VPN Authorization Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2ClientVpnAuthorizationRuleConfig(rInt int) string {
+	return testAccAWSEc2ClientVpnNetworkAssociationConfig(rInt) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "${aws_subnet.test.cidr_block}"
+  authorize_all_groups   = true
+  description            = "example authorization rule"
+}
+`)
+}
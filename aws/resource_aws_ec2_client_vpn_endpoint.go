@@ -0,0 +1,388 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnEndpointCreate,
+		Read:   resourceAwsEc2ClientVpnEndpointRead,
+		Update: resourceAwsEc2ClientVpnEndpointUpdate,
+		Delete: resourceAwsEc2ClientVpnEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication_options": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_directory_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"root_certificate_chain_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ClientVpnAuthenticationTypeCertificateAuthentication,
+								ec2.ClientVpnAuthenticationTypeDirectoryServiceAuthentication,
+							}, false),
+						},
+					},
+				},
+			},
+			"client_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"connection_log_options": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_group": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cloudwatch_log_stream": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_servers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.SingleIP(),
+				},
+			},
+			"server_certificate_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"transport_protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.TransportProtocolUdp,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TransportProtocolTcp,
+					ec2.TransportProtocolUdp,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateClientVpnEndpointInput{
+		AuthenticationOptions: expandEc2ClientVpnAuthenticationRequests(d.Get("authentication_options").([]interface{})),
+		ClientCidrBlock:       aws.String(d.Get("client_cidr_block").(string)),
+		ConnectionLogOptions:  expandEc2ClientVpnConnectionLogOptions(d.Get("connection_log_options").([]interface{})),
+		ServerCertificateArn:  aws.String(d.Get("server_certificate_arn").(string)),
+		TransportProtocol:     aws.String(d.Get("transport_protocol").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dns_servers"); ok && len(v.([]interface{})) > 0 {
+		input.DnsServers = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Client VPN Endpoint: %s", input)
+	output, err := conn.CreateClientVpnEndpoint(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Client VPN Endpoint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ClientVpnEndpointId))
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error adding EC2 Client VPN Endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnEndpointRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpoint, err := ec2DescribeClientVpnEndpoint(conn, d.Id())
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Endpoint: %s", err)
+	}
+
+	if clientVpnEndpoint == nil {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var status string
+	if clientVpnEndpoint.Status != nil {
+		status = aws.StringValue(clientVpnEndpoint.Status.Code)
+	}
+
+	if status == ec2.ClientVpnEndpointStatusCodeDeleting || status == ec2.ClientVpnEndpointStatusCodeDeleted {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) in deleted state (%s), removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("authentication_options", flattenEc2ClientVpnAuthentications(clientVpnEndpoint.AuthenticationOptions)); err != nil {
+		return fmt.Errorf("error setting authentication_options: %s", err)
+	}
+
+	d.Set("client_cidr_block", clientVpnEndpoint.ClientCidrBlock)
+
+	if err := d.Set("connection_log_options", flattenEc2ClientVpnConnectionLogOptions(clientVpnEndpoint.ConnectionLogOptions)); err != nil {
+		return fmt.Errorf("error setting connection_log_options: %s", err)
+	}
+
+	d.Set("description", clientVpnEndpoint.Description)
+	d.Set("dns_name", clientVpnEndpoint.DnsName)
+	// dns_servers is not returned by the API
+	d.Set("server_certificate_arn", clientVpnEndpoint.ServerCertificateArn)
+	d.Set("status", status)
+	d.Set("transport_protocol", clientVpnEndpoint.TransportProtocol)
+
+	tagsResp, err := conn.DescribeTags(&ec2.DescribeTagsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: []*string{aws.String(d.Id())},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	var tags []*ec2.Tag
+	for _, t := range tagsResp.Tags {
+		tags = append(tags, &ec2.Tag{
+			Key:   t.Key,
+			Value: t.Value,
+		})
+	}
+
+	if err := d.Set("tags", tagsToMap(tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("connection_log_options") || d.HasChange("description") || d.HasChange("dns_servers") || d.HasChange("server_certificate_arn") {
+		input := &ec2.ModifyClientVpnEndpointInput{
+			ClientVpnEndpointId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("connection_log_options") {
+			input.ConnectionLogOptions = expandEc2ClientVpnConnectionLogOptions(d.Get("connection_log_options").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("dns_servers") {
+			dnsServers := d.Get("dns_servers").([]interface{})
+			input.DnsServers = &ec2.DnsServersOptionsModifyStructure{
+				CustomDnsServers: expandStringList(dnsServers),
+				Enabled:          aws.Bool(len(dnsServers) > 0),
+			}
+		}
+
+		if d.HasChange("server_certificate_arn") {
+			input.ServerCertificateArn = aws.String(d.Get("server_certificate_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Client VPN Endpoint (%s): %s", d.Id(), input)
+		if _, err := conn.ModifyClientVpnEndpoint(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Client VPN Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Client VPN Endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnEndpointRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteClientVpnEndpointInput{
+		ClientVpnEndpointId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Client VPN Endpoint (%s): %s", d.Id(), input)
+	_, err := conn.DeleteClientVpnEndpoint(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Client VPN Endpoint: %s", err)
+	}
+
+	if err := waitForEc2ClientVpnEndpointDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Endpoint (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEc2ClientVpnAuthenticationRequests(l []interface{}) []*ec2.ClientVpnAuthenticationRequest {
+	requests := make([]*ec2.ClientVpnAuthenticationRequest, 0, len(l))
+
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		request := &ec2.ClientVpnAuthenticationRequest{
+			Type: aws.String(m["type"].(string)),
+		}
+
+		switch m["type"].(string) {
+		case ec2.ClientVpnAuthenticationTypeCertificateAuthentication:
+			request.MutualAuthentication = &ec2.CertificateAuthenticationRequest{
+				ClientRootCertificateChainArn: aws.String(m["root_certificate_chain_arn"].(string)),
+			}
+		case ec2.ClientVpnAuthenticationTypeDirectoryServiceAuthentication:
+			request.ActiveDirectory = &ec2.DirectoryServiceAuthenticationRequest{
+				DirectoryId: aws.String(m["active_directory_id"].(string)),
+			}
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
+func flattenEc2ClientVpnAuthentications(authentications []*ec2.ClientVpnAuthentication) []interface{} {
+	l := make([]interface{}, 0, len(authentications))
+
+	for _, authentication := range authentications {
+		if authentication == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"type": aws.StringValue(authentication.Type),
+		}
+
+		if authentication.MutualAuthentication != nil {
+			m["root_certificate_chain_arn"] = aws.StringValue(authentication.MutualAuthentication.ClientRootCertificateChain)
+		}
+
+		if authentication.ActiveDirectory != nil {
+			m["active_directory_id"] = aws.StringValue(authentication.ActiveDirectory.DirectoryId)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func expandEc2ClientVpnConnectionLogOptions(l []interface{}) *ec2.ConnectionLogOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &ec2.ConnectionLogOptions{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+
+	if v, ok := m["cloudwatch_log_group"].(string); ok && v != "" {
+		options.CloudwatchLogGroup = aws.String(v)
+	}
+
+	if v, ok := m["cloudwatch_log_stream"].(string); ok && v != "" {
+		options.CloudwatchLogStream = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenEc2ClientVpnConnectionLogOptions(options *ec2.ConnectionLogResponseOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"cloudwatch_log_group":  aws.StringValue(options.CloudwatchLogGroup),
+		"cloudwatch_log_stream": aws.StringValue(options.CloudwatchLogStream),
+		"enabled":               aws.BoolValue(options.Enabled),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,468 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+		Name: "aws_ec2_client_vpn_endpoint",
+		F:    testSweepEc2ClientVpnEndpoints,
+	})
+}
+
+func testSweepEc2ClientVpnEndpoints(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeClientVpnEndpointsInput{}
+	for {
+		output, err := conn.DescribeClientVpnEndpoints(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping EC2 Client VPN Endpoint sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("error describing EC2 Client VPN Endpoints: %s", err)
+		}
+
+		for _, clientVpnEndpoint := range output.ClientVpnEndpoints {
+			id := aws.StringValue(clientVpnEndpoint.ClientVpnEndpointId)
+
+			if !strings.HasPrefix(aws.StringValue(clientVpnEndpoint.Description), "terraform-testacc-clientvpn-") {
+				log.Printf("[INFO] Skipping EC2 Client VPN Endpoint: %s", id)
+				continue
+			}
+
+			if clientVpnEndpoint.Status != nil && aws.StringValue(clientVpnEndpoint.Status.Code) == ec2.ClientVpnEndpointStatusCodeDeleted {
+				continue
+			}
+
+			targetNetworks, err := conn.DescribeClientVpnTargetNetworks(&ec2.DescribeClientVpnTargetNetworksInput{
+				ClientVpnEndpointId: aws.String(id),
+			})
+			if err != nil {
+				return fmt.Errorf("error describing EC2 Client VPN Endpoint (%s) target networks: %s", id, err)
+			}
+
+			for _, targetNetwork := range targetNetworks.ClientVpnTargetNetworks {
+				associationID := aws.StringValue(targetNetwork.AssociationId)
+
+				log.Printf("[INFO] Deleting EC2 Client VPN Network Association: %s", associationID)
+				_, err := conn.DisassociateClientVpnTargetNetwork(&ec2.DisassociateClientVpnTargetNetworkInput{
+					AssociationId:       aws.String(associationID),
+					ClientVpnEndpointId: aws.String(id),
+				})
+				if err != nil && !isAWSErr(err, "InvalidClientVpnAssociationId.NotFound", "") {
+					return fmt.Errorf("error deleting EC2 Client VPN Network Association (%s): %s", associationID, err)
+				}
+
+				if err := waitForEc2ClientVpnNetworkAssociationDeletion(conn, id, associationID, 10*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for EC2 Client VPN Network Association (%s) deletion: %s", associationID, err)
+				}
+			}
+
+			log.Printf("[INFO] Deleting EC2 Client VPN Endpoint: %s", id)
+			_, err = conn.DeleteClientVpnEndpoint(&ec2.DeleteClientVpnEndpointInput{
+				ClientVpnEndpointId: aws.String(id),
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting EC2 Client VPN Endpoint (%s): %s", id, err)
+			}
+
+			if err := waitForEc2ClientVpnEndpointDeletion(conn, id); err != nil {
+				return fmt.Errorf("error waiting for EC2 Client VPN Endpoint (%s) deletion: %s", id, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSEc2ClientVpnEndpoint_basic(t *testing.T) {
+	var clientVpnEndpoint ec2.ClientVpnEndpoint
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "authentication_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_options.0.type", ec2.ClientVpnAuthenticationTypeCertificateAuthentication),
+					resource.TestCheckResourceAttrPair(resourceName, "authentication_options.0.root_certificate_chain_arn", "aws_acm_certificate.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "client_cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "connection_log_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_log_options.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", fmt.Sprintf("terraform-testacc-clientvpn-%d", rInt)),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_certificate_arn", "aws_acm_certificate.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.ClientVpnEndpointStatusCodePendingAssociate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "transport_protocol", ec2.TransportProtocolUdp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2ClientVpnEndpoint_disappears(t *testing.T) {
+	var clientVpnEndpoint ec2.ClientVpnEndpoint
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint),
+					testAccCheckAWSEc2ClientVpnEndpointDisappears(&clientVpnEndpoint),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2ClientVpnEndpoint_ConnectionLogOptions(t *testing.T) {
+	var clientVpnEndpoint1, clientVpnEndpoint2 ec2.ClientVpnEndpoint
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint1),
+					resource.TestCheckResourceAttr(resourceName, "connection_log_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_log_options.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfigConnectionLogOptions(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint2),
+					testAccCheckAWSEc2ClientVpnEndpointNotRecreated(&clientVpnEndpoint1, &clientVpnEndpoint2),
+					resource.TestCheckResourceAttr(resourceName, "connection_log_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_log_options.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_log_options.0.cloudwatch_log_group", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "connection_log_options.0.cloudwatch_log_stream", "aws_cloudwatch_log_stream.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2ClientVpnEndpoint_Tags(t *testing.T) {
+	var clientVpnEndpoint1, clientVpnEndpoint2, clientVpnEndpoint3 ec2.ClientVpnEndpoint
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfigTags1(rInt, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfigTags2(rInt, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint2),
+					testAccCheckAWSEc2ClientVpnEndpointNotRecreated(&clientVpnEndpoint1, &clientVpnEndpoint2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2ClientVpnEndpointConfigTags1(rInt, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnEndpointExists(resourceName, &clientVpnEndpoint3),
+					testAccCheckAWSEc2ClientVpnEndpointNotRecreated(&clientVpnEndpoint2, &clientVpnEndpoint3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSEc2ClientVpn(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	_, err := conn.DescribeClientVpnEndpoints(&ec2.DescribeClientVpnEndpointsInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSEc2ClientVpnEndpointExists(resourceName string, clientVpnEndpoint *ec2.ClientVpnEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Client VPN Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		endpoint, err := ec2DescribeClientVpnEndpoint(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if endpoint == nil {
+			return fmt.Errorf("EC2 Client VPN Endpoint not found")
+		}
+
+		*clientVpnEndpoint = *endpoint
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2ClientVpnEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_endpoint" {
+			continue
+		}
+
+		clientVpnEndpoint, err := ec2DescribeClientVpnEndpoint(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if clientVpnEndpoint == nil {
+			continue
+		}
+
+		if clientVpnEndpoint.Status != nil && aws.StringValue(clientVpnEndpoint.Status.Code) != ec2.ClientVpnEndpointStatusCodeDeleted {
+			return fmt.Errorf("EC2 Client VPN Endpoint (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(clientVpnEndpoint.Status.Code))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2ClientVpnEndpointDisappears(clientVpnEndpoint *ec2.ClientVpnEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DeleteClientVpnEndpointInput{
+			ClientVpnEndpointId: clientVpnEndpoint.ClientVpnEndpointId,
+		}
+
+		if _, err := conn.DeleteClientVpnEndpoint(input); err != nil {
+			return err
+		}
+
+		return waitForEc2ClientVpnEndpointDeletion(conn, aws.StringValue(clientVpnEndpoint.ClientVpnEndpointId))
+	}
+}
+
+func testAccCheckAWSEc2ClientVpnEndpointNotRecreated(i, j *ec2.ClientVpnEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.ClientVpnEndpointId) != aws.StringValue(j.ClientVpnEndpointId) {
+			return errors.New("EC2 Client VPN Endpoint was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2ClientVpnEndpointConfigAcmCertificateBase() string {
+	return fmt.Sprintf(`
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "test" {
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.test.private_key_pem}"
+
+  subject {
+    common_name  = "example.com"
+    organization = "ACME Examples, Inc"
+  }
+
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+
+resource "aws_acm_certificate" "test" {
+  certificate_body = "${tls_self_signed_cert.test.cert_pem}"
+  private_key      = "${tls_private_key.test.private_key_pem}"
+}
+`)
+}
+
+func testAccAWSEc2ClientVpnEndpointConfig(rInt int) string {
+	return testAccAWSEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  description            = "terraform-testacc-clientvpn-%d"
+  server_certificate_arn = "${aws_acm_certificate.test.arn}"
+  client_cidr_block      = "10.0.0.0/16"
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = "${aws_acm_certificate.test.arn}"
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+}
+`, rInt)
+}
+
+func testAccAWSEc2ClientVpnEndpointConfigConnectionLogOptions(rInt int) string {
+	return testAccAWSEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = "terraform-testacc-clientvpn-loggroup-%[1]d"
+}
+
+resource "aws_cloudwatch_log_stream" "test" {
+  name           = "terraform-testacc-clientvpn-logstream-%[1]d"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  description            = "terraform-testacc-clientvpn-%[1]d"
+  server_certificate_arn = "${aws_acm_certificate.test.arn}"
+  client_cidr_block      = "10.0.0.0/16"
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = "${aws_acm_certificate.test.arn}"
+  }
+
+  connection_log_options {
+    enabled               = true
+    cloudwatch_log_group  = "${aws_cloudwatch_log_group.test.name}"
+    cloudwatch_log_stream = "${aws_cloudwatch_log_stream.test.name}"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSEc2ClientVpnEndpointConfigTags1(rInt int, tagKey1, tagValue1 string) string {
+	return testAccAWSEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  description            = "terraform-testacc-clientvpn-%d"
+  server_certificate_arn = "${aws_acm_certificate.test.arn}"
+  client_cidr_block      = "10.0.0.0/16"
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = "${aws_acm_certificate.test.arn}"
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+
+  tags = {
+    %q = %q
+  }
+}
+`, rInt, tagKey1, tagValue1)
+}
+
+func testAccAWSEc2ClientVpnEndpointConfigTags2(rInt int, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSEc2ClientVpnEndpointConfigAcmCertificateBase() + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_endpoint" "test" {
+  description            = "terraform-testacc-clientvpn-%d"
+  server_certificate_arn = "${aws_acm_certificate.test.arn}"
+  client_cidr_block      = "10.0.0.0/16"
+
+  authentication_options {
+    type                       = "certificate-authentication"
+    root_certificate_chain_arn = "${aws_acm_certificate.test.arn}"
+  }
+
+  connection_log_options {
+    enabled = false
+  }
+
+  tags = {
+    %q = %q
+    %q = %q
+  }
+}
+`, rInt, tagKey1, tagValue1, tagKey2, tagValue2)
+}
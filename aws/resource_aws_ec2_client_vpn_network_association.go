@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ClientVpnNetworkAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnNetworkAssociationCreate,
+		Read:   resourceAwsEc2ClientVpnNetworkAssociationRead,
+		Delete: resourceAwsEc2ClientVpnNetworkAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnNetworkAssociationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnNetworkAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+
+	input := &ec2.AssociateClientVpnTargetNetworkInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		SubnetId:            aws.String(d.Get("subnet_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Client VPN Network Association: %s", input)
+	output, err := conn.AssociateClientVpnTargetNetwork(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Client VPN Network Association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AssociationId))
+
+	if err := waitForEc2ClientVpnNetworkAssociationCreation(conn, clientVpnEndpointID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Network Association (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnNetworkAssociationRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnNetworkAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+
+	targetNetwork, err := ec2DescribeClientVpnNetworkAssociation(conn, clientVpnEndpointID, d.Id())
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnAssociationId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Network Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Network Association: %s", err)
+	}
+
+	if targetNetwork == nil {
+		log.Printf("[WARN] EC2 Client VPN Network Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var status string
+	if targetNetwork.Status != nil {
+		status = aws.StringValue(targetNetwork.Status.Code)
+	}
+
+	if status == ec2.AssociationStatusCodeDisassociating || status == ec2.AssociationStatusCodeDisassociated {
+		log.Printf("[WARN] EC2 Client VPN Network Association (%s) in disassociated state (%s), removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", targetNetwork.ClientVpnEndpointId)
+
+	if err := d.Set("security_groups", aws.StringValueSlice(targetNetwork.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_groups: %s", err)
+	}
+
+	d.Set("status", status)
+	d.Set("subnet_id", targetNetwork.TargetNetworkId)
+	d.Set("vpc_id", targetNetwork.VpcId)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnNetworkAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+
+	input := &ec2.DisassociateClientVpnTargetNetworkInput{
+		AssociationId:       aws.String(d.Id()),
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Client VPN Network Association (%s): %s", d.Id(), input)
+	_, err := conn.DisassociateClientVpnTargetNetwork(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnAssociationId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Client VPN Network Association: %s", err)
+	}
+
+	if err := waitForEc2ClientVpnNetworkAssociationDeletion(conn, clientVpnEndpointID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Network Association (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnNetworkAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clientVpnEndpointID, associationID, err := decodeEc2ClientVpnNetworkAssociationImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("client_vpn_endpoint_id", clientVpnEndpointID)
+	d.SetId(associationID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2ClientVpnNetworkAssociation_basic(t *testing.T) {
+	var targetNetwork ec2.TargetNetwork
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_network_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnNetworkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnNetworkAssociationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnNetworkAssociationExists(resourceName, &targetNetwork),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", "aws_ec2_client_vpn_endpoint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.AssociationStatusCodeAssociated),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSEc2ClientVpnNetworkAssociationImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2ClientVpnNetworkAssociation_disappears(t *testing.T) {
+	var targetNetwork ec2.TargetNetwork
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_network_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnNetworkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnNetworkAssociationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnNetworkAssociationExists(resourceName, &targetNetwork),
+					testAccCheckAWSEc2ClientVpnNetworkAssociationDisappears(&targetNetwork),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2ClientVpnNetworkAssociationExists(resourceName string, targetNetwork *ec2.TargetNetwork) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Client VPN Network Association ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		association, err := ec2DescribeClientVpnNetworkAssociation(conn, rs.Primary.Attributes["client_vpn_endpoint_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if association == nil {
+			return fmt.Errorf("EC2 Client VPN Network Association not found")
+		}
+
+		*targetNetwork = *association
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2ClientVpnNetworkAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_network_association" {
+			continue
+		}
+
+		targetNetwork, err := ec2DescribeClientVpnNetworkAssociation(conn, rs.Primary.Attributes["client_vpn_endpoint_id"], rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnAssociationId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if targetNetwork == nil {
+			continue
+		}
+
+		if targetNetwork.Status != nil && aws.StringValue(targetNetwork.Status.Code) != ec2.AssociationStatusCodeDisassociated {
+			return fmt.Errorf("EC2 Client VPN Network Association (%s) still exists in non-disassociated (%s) state", rs.Primary.ID, aws.StringValue(targetNetwork.Status.Code))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2ClientVpnNetworkAssociationDisappears(targetNetwork *ec2.TargetNetwork) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DisassociateClientVpnTargetNetworkInput{
+			AssociationId:       targetNetwork.AssociationId,
+			ClientVpnEndpointId: targetNetwork.ClientVpnEndpointId,
+		}
+
+		if _, err := conn.DisassociateClientVpnTargetNetwork(input); err != nil {
+			return err
+		}
+
+		return waitForEc2ClientVpnNetworkAssociationDeletion(conn, aws.StringValue(targetNetwork.ClientVpnEndpointId), aws.StringValue(targetNetwork.AssociationId), 10*time.Minute)
+	}
+}
+
+func testAccAWSEc2ClientVpnNetworkAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s_%s", rs.Primary.Attributes["client_vpn_endpoint_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSEc2ClientVpnNetworkAssociationConfigBase(rInt int) string {
+	return testAccAWSEc2ClientVpnEndpointConfig(rInt) + fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-clientvpn-%[1]d"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = "terraform-testacc-clientvpn-%[1]d"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSEc2ClientVpnNetworkAssociationConfig(rInt int) string {
+	return testAccAWSEc2ClientVpnNetworkAssociationConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_network_association" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  subnet_id              = "${aws_subnet.test.id}"
+}
+`)
+}
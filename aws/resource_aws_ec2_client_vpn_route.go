@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ClientVpnRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnRouteCreate,
+		Read:   resourceAwsEc2ClientVpnRouteRead,
+		Delete: resourceAwsEc2ClientVpnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_vpc_subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+	destination := d.Get("destination_cidr_block").(string)
+	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
+
+	input := &ec2.CreateClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(clientVpnEndpointID),
+		DestinationCidrBlock: aws.String(destination),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Client VPN Route: %s", input)
+	_, err := conn.CreateClientVpnRoute(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Client VPN Route: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_%s", clientVpnEndpointID, targetSubnetID, destination))
+
+	if err := waitForEc2ClientVpnRouteCreation(conn, clientVpnEndpointID, targetSubnetID, destination); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Route (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnRouteRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetSubnetID, destination, err := decodeEc2ClientVpnRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destination)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) not found, removing from state", clientVpnEndpointID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Route: %s", err)
+	}
+
+	if route == nil {
+		log.Printf("[WARN] EC2 Client VPN Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var status string
+	if route.Status != nil {
+		status = aws.StringValue(route.Status.Code)
+	}
+
+	if status == ec2.ClientVpnRouteStatusCodeDeleting {
+		log.Printf("[WARN] EC2 Client VPN Route (%s) deleting, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", route.ClientVpnEndpointId)
+	d.Set("description", route.Description)
+	d.Set("destination_cidr_block", route.DestinationCidr)
+	d.Set("origin", route.Origin)
+	d.Set("status", status)
+	d.Set("target_vpc_subnet_id", route.TargetSubnet)
+	d.Set("type", route.Type)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetSubnetID, destination, err := decodeEc2ClientVpnRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.DeleteClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(clientVpnEndpointID),
+		DestinationCidrBlock: aws.String(destination),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Client VPN Route (%s): %s", d.Id(), input)
+	_, err = conn.DeleteClientVpnRoute(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnRouteNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Client VPN Route: %s", err)
+	}
+
+	if err := waitForEc2ClientVpnRouteDeletion(conn, clientVpnEndpointID, targetSubnetID, destination); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Route (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
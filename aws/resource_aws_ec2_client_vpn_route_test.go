@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2ClientVpnRoute_basic(t *testing.T) {
+	var route ec2.ClientVpnRoute
+	rInt := acctest.RandInt()
+	resourceName := "aws_ec2_client_vpn_route.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2ClientVpn(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ClientVpnRouteConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ClientVpnRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", "aws_ec2_client_vpn_endpoint.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "example route"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "origin", "add-route"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.ClientVpnRouteStatusCodeActive),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Nat"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2ClientVpnRouteExists(resourceName string, clientVpnRoute *ec2.ClientVpnRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Client VPN Route ID is set")
+		}
+
+		clientVpnEndpointID, targetSubnetID, destination, err := decodeEc2ClientVpnRouteID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destination)
+
+		if err != nil {
+			return err
+		}
+
+		if route == nil {
+			return fmt.Errorf("EC2 Client VPN Route not found")
+		}
+
+		*clientVpnRoute = *route
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2ClientVpnRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_route" {
+			continue
+		}
+
+		clientVpnEndpointID, targetSubnetID, destination, err := decodeEc2ClientVpnRouteID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destination)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if route != nil {
+			return fmt.Errorf("EC2 Client VPN Route (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2ClientVpnRouteConfig(rInt int) string {
+	return testAccAWSEc2ClientVpnNetworkAssociationConfig(rInt) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_route" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  destination_cidr_block = "10.2.0.0/16"
+  target_vpc_subnet_id   = "${aws_ec2_client_vpn_network_association.test.subnet_id}"
+  description            = "example route"
+}
+`)
+}
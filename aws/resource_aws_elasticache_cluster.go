@@ -310,7 +310,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		securityIdSet := d.Get("security_group_ids").(*schema.Set)
 		securityNames := expandStringList(securityNameSet.List())
 		securityIds := expandStringList(securityIdSet.List())
-		tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+		tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 
 		req.CacheSecurityGroupNames = securityNames
 		req.SecurityGroupIds = securityIds
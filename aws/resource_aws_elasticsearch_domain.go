@@ -28,9 +28,18 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 			State: resourceAwsElasticSearchDomainImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ForceNewIf("elasticsearch_version", func(d *schema.ResourceDiff, meta interface{}) bool {
-				newVersion := d.Get("elasticsearch_version").(string)
+				// Only existing domains can be upgraded in place
+				if d.Id() == "" || !d.HasChange("elasticsearch_version") {
+					return false
+				}
+
+				o, n := d.GetChange("elasticsearch_version")
 				domainName := d.Get("domain_name").(string)
 
 				conn := meta.(*AWSClient).esconn
@@ -38,17 +47,22 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 					DomainName: aws.String(domainName),
 				})
 				if err != nil {
-					log.Printf("[ERROR] Failed to get compatible ElasticSearch versions %s", domainName)
+					log.Printf("[WARN] Failed to get compatible ElasticSearch versions for %q: %s", domainName, err)
 					return false
 				}
-				if len(resp.CompatibleElasticsearchVersions) != 1 {
-					return true
-				}
-				for _, targetVersion := range resp.CompatibleElasticsearchVersions[0].TargetVersions {
-					if aws.StringValue(targetVersion) == newVersion {
-						return false
+
+				for _, compatibleVersions := range resp.CompatibleElasticsearchVersions {
+					if aws.StringValue(compatibleVersions.SourceVersion) != o.(string) {
+						continue
+					}
+					for _, targetVersion := range compatibleVersions.TargetVersions {
+						if aws.StringValue(targetVersion) == n.(string) {
+							return false
+						}
 					}
 				}
+
+				log.Printf("[DEBUG] No upgrade path for ElasticSearch domain %q from %q to %q, domain must be replaced", domainName, o, n)
 				return true
 			}),
 		),
@@ -508,7 +522,7 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapElasticsearchService(d.Get("tags_all").(map[string]interface{}))
 
 	if err := setTagsElasticsearchService(conn, d, aws.StringValue(out.DomainStatus.ARN)); err != nil {
 		return err
@@ -516,6 +530,7 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 
 	d.Set("tags", tagsToMapElasticsearchService(tags))
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
 	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
@@ -675,6 +690,8 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 
 	d.SetPartial("tags")
 
+	d.SetPartial("tags_all")
+
 	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
 	}
@@ -773,32 +790,17 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 			TargetVersion: aws.String(d.Get("elasticsearch_version").(string)),
 		}
 
+		log.Printf("[DEBUG] Upgrading ElasticSearch domain: %s", upgradeInput)
 		_, err := conn.UpgradeElasticsearchDomain(&upgradeInput)
 		if err != nil {
 			return fmt.Errorf("Failed to upgrade elasticsearch domain: %s", err)
 		}
 
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{elasticsearch.UpgradeStatusInProgress},
-			Target:  []string{elasticsearch.UpgradeStatusSucceeded},
-			Refresh: func() (interface{}, string, error) {
-				out, err := conn.GetUpgradeStatus(&elasticsearch.GetUpgradeStatusInput{
-					DomainName: aws.String(d.Get("domain_name").(string)),
-				})
-				if err != nil {
-					return nil, "", err
-				}
-
-				return out, aws.StringValue(out.StepStatus), nil
-			},
-			Timeout:    60 * time.Minute, // TODO: Make this configurable. Large ES domains may take a very long time to upgrade
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // The upgrade status isn't instantly available for the current upgrade so will either be nil or reflect a previous upgrade
-		}
-		_, waitErr := stateConf.WaitForState()
-		if waitErr != nil {
-			return waitErr
+		log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be upgraded", d.Id())
+		if err := waitForElasticSearchDomainUpgrade(conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ElasticSearch domain (%s) upgrade: %s", d.Id(), err)
 		}
+		d.SetPartial("elasticsearch_version")
 	}
 
 	d.Partial(false)
@@ -806,6 +808,60 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 	return resourceAwsElasticSearchDomainRead(d, meta)
 }
 
+func waitForElasticSearchDomainUpgrade(conn *elasticsearch.ElasticsearchService, domainName string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			elasticsearch.UpgradeStepPreUpgradeCheck,
+			elasticsearch.UpgradeStepSnapshot,
+			elasticsearch.UpgradeStepUpgrade,
+		},
+		Target:  []string{elasticsearch.UpgradeStatusSucceeded},
+		Refresh: elasticSearchDomainUpgradeStateRefreshFunc(conn, domainName),
+		Timeout: timeout,
+		// The upgrade status isn't instantly available for the current upgrade so will either be nil or reflect a previous upgrade
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// elasticSearchDomainUpgradeStateRefreshFunc reports the current upgrade step
+// while it is in progress so that waiting continues through the pre-upgrade
+// check and snapshot steps, and only completes once the upgrade step itself
+// has succeeded.
+func elasticSearchDomainUpgradeStateRefreshFunc(conn *elasticsearch.ElasticsearchService, domainName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.GetUpgradeStatus(&elasticsearch.GetUpgradeStatusInput{
+			DomainName: aws.String(domainName),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		step := aws.StringValue(out.UpgradeStep)
+		status := aws.StringValue(out.StepStatus)
+		log.Printf("[DEBUG] ElasticSearch domain %q upgrade %q step %s: %s", domainName, aws.StringValue(out.UpgradeName), step, status)
+
+		switch status {
+		case elasticsearch.UpgradeStatusFailed:
+			return out, status, fmt.Errorf("upgrade %q failed during %s step", aws.StringValue(out.UpgradeName), step)
+		case elasticsearch.UpgradeStatusSucceeded, elasticsearch.UpgradeStatusSucceededWithIssues:
+			if step != elasticsearch.UpgradeStepUpgrade {
+				return out, step, nil
+			}
+			if status == elasticsearch.UpgradeStatusSucceededWithIssues {
+				log.Printf("[WARN] ElasticSearch domain %q upgrade %q succeeded with issues", domainName, aws.StringValue(out.UpgradeName))
+			}
+			return out, elasticsearch.UpgradeStatusSucceeded, nil
+		}
+
+		return out, step, nil
+	}
+}
+
 func resourceAwsElasticSearchDomainDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 	domainName := d.Get("domain_name").(string)
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -591,6 +592,37 @@ func TestAccAWSElasticSearchDomain_update_version(t *testing.T) {
 		}})
 }
 
+func TestAccAWSElasticSearchDomain_downgrade_version(t *testing.T) {
+	var domain elasticsearch.ElasticsearchDomainStatus
+	var created1, created2 time.Time
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckESDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccESDomainConfig_ClusterUpdateVersion(ri, "5.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain),
+					testAccCheckAWSESDomainCreationDate(&domain, &created1),
+					resource.TestCheckResourceAttr("aws_elasticsearch_domain.example", "elasticsearch_version", "5.6"),
+				),
+			},
+			{
+				// There is no upgrade path to an older version so the domain must be replaced
+				Config: testAccESDomainConfig_ClusterUpdateVersion(ri, "5.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckESDomainExists("aws_elasticsearch_domain.example", &domain),
+					testAccCheckAWSESDomainCreationDate(&domain, &created2),
+					testAccCheckAWSESDomainRecreated(&created1, &created2),
+					resource.TestCheckResourceAttr("aws_elasticsearch_domain.example", "elasticsearch_version", "5.5"),
+				),
+			},
+		}})
+}
+
 func testAccCheckESEBSVolumeSize(ebsVolumeSize int, status *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conf := status.EBSOptions
@@ -730,6 +762,33 @@ func testAccCheckAWSESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomain
 	}
 }
 
+func testAccCheckAWSESDomainCreationDate(domain *elasticsearch.ElasticsearchDomainStatus, created *time.Time) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).esconn
+
+		config, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
+			DomainName: domain.DomainName,
+		})
+		if err != nil {
+			return err
+		}
+
+		*created = aws.TimeValue(config.DomainConfig.ElasticsearchClusterConfig.Status.CreationDate)
+
+		return nil
+	}
+}
+
+func testAccCheckAWSESDomainRecreated(i, j *time.Time) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if i.Equal(*j) {
+			return fmt.Errorf("ES Domain was not recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckESDomainDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticsearch_domain" {
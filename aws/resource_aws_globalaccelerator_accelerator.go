@@ -0,0 +1,359 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorAcceleratorCreate,
+		Read:   resourceAwsGlobalAcceleratorAcceleratorRead,
+		Update: resourceAwsGlobalAcceleratorAcceleratorUpdate,
+		Delete: resourceAwsGlobalAcceleratorAcceleratorDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9A-Za-z-]{1,32}$`),
+					"only alphanumeric characters and hyphens are allowed, up to 32 characters",
+				),
+			},
+			"ip_address_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  globalaccelerator.IpAddressTypeIpv4,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.IpAddressTypeIpv4,
+				}, false),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ip_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ip_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_logs_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"flow_logs_s3_bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"flow_logs_s3_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.CreateAcceleratorInput{
+		Name:             aws.String(d.Get("name").(string)),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Enabled:          aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("ip_address_type"); ok {
+		opts.IpAddressType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Create Global Accelerator accelerator: %s", opts)
+
+	resp, err := conn.CreateAccelerator(opts)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator accelerator: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Accelerator.AcceleratorArn))
+
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("attributes"); ok {
+		if err := resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn, d.Id(), d.Timeout(schema.TimeoutCreate), v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGlobalAcceleratorAcceleratorRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	accelerator, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator accelerator (%s): %s", d.Id(), err)
+	}
+
+	if accelerator == nil {
+		log.Printf("[WARN] Global Accelerator accelerator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", accelerator.Name)
+	d.Set("ip_address_type", accelerator.IpAddressType)
+	d.Set("enabled", accelerator.Enabled)
+	if err := d.Set("ip_sets", resourceAwsGlobalAcceleratorAcceleratorFlattenIpSets(accelerator.IpSets)); err != nil {
+		return fmt.Errorf("error setting ip_sets: %s", err)
+	}
+
+	resp, err := conn.DescribeAcceleratorAttributes(&globalaccelerator.DescribeAcceleratorAttributesInput{
+		AcceleratorArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator accelerator (%s) attributes: %s", d.Id(), err)
+	}
+
+	if err := d.Set("attributes", resourceAwsGlobalAcceleratorAcceleratorFlattenAttributes(resp.AcceleratorAttributes)); err != nil {
+		return fmt.Errorf("error setting attributes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorFlattenIpSets(ipsets []*globalaccelerator.IpSet) []interface{} {
+	out := make([]interface{}, len(ipsets))
+
+	for i, ipset := range ipsets {
+		out[i] = map[string]interface{}{
+			"ip_addresses": flattenStringList(ipset.IpAddresses),
+			"ip_family":    aws.StringValue(ipset.IpFamily),
+		}
+	}
+
+	return out
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorFlattenAttributes(attributes *globalaccelerator.AcceleratorAttributes) []interface{} {
+	if attributes == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"flow_logs_enabled":   aws.BoolValue(attributes.FlowLogsEnabled),
+		"flow_logs_s3_bucket": aws.StringValue(attributes.FlowLogsS3Bucket),
+		"flow_logs_s3_prefix": aws.StringValue(attributes.FlowLogsS3Prefix),
+	}
+
+	return []interface{}{m}
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorStateRefreshFunc(conn *globalaccelerator.GlobalAccelerator, acceleratorArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accelerator, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, acceleratorArn)
+		if err != nil {
+			log.Printf("[ERROR] Error retrieving Global Accelerator accelerator when waiting: %s", err)
+			return nil, "", err
+		}
+
+		if accelerator == nil {
+			return nil, "", nil
+		}
+
+		return accelerator, aws.StringValue(accelerator.Status), nil
+	}
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn *globalaccelerator.GlobalAccelerator, acceleratorArn string) (*globalaccelerator.Accelerator, error) {
+	resp, err := conn.DescribeAccelerator(&globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: aws.String(acceleratorArn),
+	})
+	if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Accelerator, nil
+}
+
+// waitForGlobalAcceleratorAcceleratorDeployment waits for any changes to the
+// accelerator, its listeners or its endpoint groups to be deployed.
+func waitForGlobalAcceleratorAcceleratorDeployment(conn *globalaccelerator.GlobalAccelerator, acceleratorArn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalaccelerator.AcceleratorStatusInProgress},
+		Target:  []string{globalaccelerator.AcceleratorStatusDeployed},
+		Refresh: resourceAwsGlobalAcceleratorAcceleratorStateRefreshFunc(conn, acceleratorArn),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for Global Accelerator accelerator (%s) availability", acceleratorArn)
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator accelerator (%s) availability: %s", acceleratorArn, err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	d.Partial(true)
+
+	if d.HasChange("name") || d.HasChange("ip_address_type") || d.HasChange("enabled") {
+		opts := &globalaccelerator.UpdateAcceleratorInput{
+			AcceleratorArn: aws.String(d.Id()),
+			Name:           aws.String(d.Get("name").(string)),
+			Enabled:        aws.Bool(d.Get("enabled").(bool)),
+		}
+
+		if v, ok := d.GetOk("ip_address_type"); ok {
+			opts.IpAddressType = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Update Global Accelerator accelerator: %s", opts)
+
+		_, err := conn.UpdateAccelerator(opts)
+		if err != nil {
+			return fmt.Errorf("error updating Global Accelerator accelerator (%s): %s", d.Id(), err)
+		}
+
+		d.SetPartial("name")
+		d.SetPartial("ip_address_type")
+		d.SetPartial("enabled")
+
+		if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("attributes") {
+		if err := resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn, d.Id(), d.Timeout(schema.TimeoutUpdate), d.Get("attributes").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("attributes")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsGlobalAcceleratorAcceleratorRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorUpdateAttributes(conn *globalaccelerator.GlobalAccelerator, acceleratorArn string, timeout time.Duration, attributes []interface{}) error {
+	opts := &globalaccelerator.UpdateAcceleratorAttributesInput{
+		AcceleratorArn:  aws.String(acceleratorArn),
+		FlowLogsEnabled: aws.Bool(false),
+	}
+
+	if len(attributes) > 0 && attributes[0] != nil {
+		m := attributes[0].(map[string]interface{})
+
+		opts.FlowLogsEnabled = aws.Bool(m["flow_logs_enabled"].(bool))
+
+		if v := m["flow_logs_s3_bucket"].(string); v != "" {
+			opts.FlowLogsS3Bucket = aws.String(v)
+		}
+
+		if v := m["flow_logs_s3_prefix"].(string); v != "" {
+			opts.FlowLogsS3Prefix = aws.String(v)
+		}
+	}
+
+	log.Printf("[DEBUG] Update Global Accelerator accelerator attributes: %s", opts)
+
+	_, err := conn.UpdateAcceleratorAttributes(opts)
+	if err != nil {
+		return fmt.Errorf("error updating Global Accelerator accelerator (%s) attributes: %s", acceleratorArn, err)
+	}
+
+	return waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, timeout)
+}
+
+func resourceAwsGlobalAcceleratorAcceleratorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	// An accelerator must be disabled before it can be deleted.
+	if d.Get("enabled").(bool) {
+		opts := &globalaccelerator.UpdateAcceleratorInput{
+			AcceleratorArn: aws.String(d.Id()),
+			Enabled:        aws.Bool(false),
+		}
+
+		log.Printf("[DEBUG] Disabling Global Accelerator accelerator: %s", opts)
+
+		_, err := conn.UpdateAccelerator(opts)
+		if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error disabling Global Accelerator accelerator (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	opts := &globalaccelerator.DeleteAcceleratorInput{
+		AcceleratorArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator accelerator: %s", opts)
+
+	_, err := conn.DeleteAccelerator(opts)
+	if isAWSErr(err, globalaccelerator.ErrCodeAcceleratorNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator accelerator (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
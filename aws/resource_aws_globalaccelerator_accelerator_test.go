@@ -0,0 +1,288 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+		Name: "aws_globalaccelerator_accelerator",
+		F:    testSweepGlobalAcceleratorAccelerators,
+	})
+}
+
+func testSweepGlobalAcceleratorAccelerators(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).globalacceleratorconn
+
+	input := &globalaccelerator.ListAcceleratorsInput{}
+	for {
+		output, err := conn.ListAccelerators(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping Global Accelerator accelerators sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Global Accelerator accelerators: %s", err)
+		}
+
+		for _, accelerator := range output.Accelerators {
+			arn := aws.StringValue(accelerator.AcceleratorArn)
+
+			if !strings.HasPrefix(aws.StringValue(accelerator.Name), "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Global Accelerator accelerator: %s", arn)
+				continue
+			}
+
+			if aws.BoolValue(accelerator.Enabled) {
+				log.Printf("[INFO] Disabling Global Accelerator accelerator: %s", arn)
+				_, err := conn.UpdateAccelerator(&globalaccelerator.UpdateAcceleratorInput{
+					AcceleratorArn: aws.String(arn),
+					Enabled:        aws.Bool(false),
+				})
+				if err != nil {
+					log.Printf("[ERROR] Failed to disable Global Accelerator accelerator %s: %s", arn, err)
+					continue
+				}
+
+				if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, arn, 5*time.Minute); err != nil {
+					log.Printf("[ERROR] %s", err)
+					continue
+				}
+			}
+
+			log.Printf("[INFO] Deleting Global Accelerator accelerator: %s", arn)
+			_, err := conn.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{
+				AcceleratorArn: aws.String(arn),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Global Accelerator accelerator %s: %s", arn, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAwsGlobalAcceleratorAccelerator_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	ipRegex := regexp.MustCompile(`\d+\.\d+\.\d+\.\d+`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAccelerator_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "IPV4"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_s3_bucket", ""),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_s3_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.0.ip_addresses.#", "2"),
+					resource.TestMatchResourceAttr(resourceName, "ip_sets.0.ip_addresses.0", ipRegex),
+					resource.TestMatchResourceAttr(resourceName, "ip_sets.0.ip_addresses.1", ipRegex),
+					resource.TestCheckResourceAttr(resourceName, "ip_sets.0.ip_family", "IPv4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsGlobalAcceleratorAccelerator_update(t *testing.T) {
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	newName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAccelerator_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName),
+				),
+			},
+			{
+				Config: testAccGlobalAcceleratorAccelerator_basic(newName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsGlobalAcceleratorAccelerator_attributes(t *testing.T) {
+	resourceName := "aws_globalaccelerator_accelerator.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorAccelerator_attributes(rName, false, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalAcceleratorAccelerator_attributes(rName, true, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "attributes.0.flow_logs_s3_bucket", "aws_s3_bucket.example", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_s3_prefix", "foo"),
+				),
+			},
+			{
+				Config: testAccGlobalAcceleratorAccelerator_attributes(rName, true, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorAcceleratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.flow_logs_s3_prefix", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckGlobalAccelerator(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	input := &globalaccelerator.ListAcceleratorsInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.ListAccelerators(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		accelerator, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if accelerator == nil {
+			return fmt.Errorf("Global Accelerator accelerator not found")
+		}
+
+		if aws.StringValue(accelerator.Status) != globalaccelerator.AcceleratorStatusDeployed {
+			return fmt.Errorf("Global Accelerator accelerator (%s) status is %s", rs.Primary.ID, aws.StringValue(accelerator.Status))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_accelerator" {
+			continue
+		}
+
+		accelerator, err := resourceAwsGlobalAcceleratorAcceleratorRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if accelerator != nil {
+			return fmt.Errorf("Global Accelerator accelerator still exists")
+		}
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorAccelerator_basic(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name            = %q
+  ip_address_type = "IPV4"
+  enabled         = %t
+}
+`, rName, enabled)
+}
+
+func testAccGlobalAcceleratorAccelerator_attributes(rName string, flowLogsEnabled bool, flowLogsPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "example" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_globalaccelerator_accelerator" "example" {
+  name            = %[1]q
+  ip_address_type = "IPV4"
+  enabled         = false
+
+  attributes {
+    flow_logs_enabled   = %[2]t
+    flow_logs_s3_bucket = "${aws_s3_bucket.example.bucket}"
+    flow_logs_s3_prefix = %[3]q
+  }
+}
+`, rName, flowLogsEnabled, flowLogsPrefix)
+}
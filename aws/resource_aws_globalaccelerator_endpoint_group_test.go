@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsGlobalAcceleratorEndpointGroupParseListenerArn(t *testing.T) {
+	cases := []struct {
+		Input         string
+		ExpectedArn   string
+		ExpectedError bool
+	}{
+		{
+			Input:         "",
+			ExpectedError: true,
+		},
+		{
+			Input:         "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz",
+			ExpectedError: true,
+		},
+		{
+			Input:       "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz/endpoint-group/098765zyxwvu",
+			ExpectedArn: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz",
+		},
+	}
+
+	for _, tc := range cases {
+		arn, err := resourceAwsGlobalAcceleratorEndpointGroupParseListenerArn(tc.Input)
+
+		if tc.ExpectedError && err == nil {
+			t.Fatalf("expected error for input %q", tc.Input)
+		}
+
+		if !tc.ExpectedError && err != nil {
+			t.Fatalf("unexpected error for input %q: %s", tc.Input, err)
+		}
+
+		if arn != tc.ExpectedArn {
+			t.Fatalf("expected ARN %q for input %q, got %q", tc.ExpectedArn, tc.Input, arn)
+		}
+	}
+}
+
+func TestAccAwsGlobalAcceleratorEndpointGroup_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_endpoint_group.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorEndpointGroup_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", "aws_globalaccelerator_listener.example", "id"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_group_region", testAccGetRegion()),
+					resource.TestCheckResourceAttr(resourceName, "health_check_interval_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "100"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsGlobalAcceleratorEndpointGroup_update(t *testing.T) {
+	resourceName := "aws_globalaccelerator_endpoint_group.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorEndpointGroup_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorEndpointGroupExists(resourceName),
+				),
+			},
+			{
+				Config: testAccGlobalAcceleratorEndpointGroup_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_check_interval_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/foo"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_protocol", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_dial_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorEndpointGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		endpointGroup, err := resourceAwsGlobalAcceleratorEndpointGroupRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if endpointGroup == nil {
+			return fmt.Errorf("Global Accelerator endpoint group not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorEndpointGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_endpoint_group" {
+			continue
+		}
+
+		endpointGroup, err := resourceAwsGlobalAcceleratorEndpointGroupRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if endpointGroup != nil {
+			return fmt.Errorf("Global Accelerator endpoint group still exists")
+		}
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorEndpointGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name            = %q
+  ip_address_type = "IPV4"
+  enabled         = false
+}
+
+resource "aws_globalaccelerator_listener" "example" {
+  accelerator_arn = "${aws_globalaccelerator_accelerator.example.id}"
+  protocol        = "TCP"
+
+  port_range {
+    from_port = 80
+    to_port   = 80
+  }
+}
+`, rName)
+}
+
+func testAccGlobalAcceleratorEndpointGroup_basic(rName string) string {
+	return testAccGlobalAcceleratorEndpointGroupConfig_base(rName) + `
+resource "aws_globalaccelerator_endpoint_group" "example" {
+  listener_arn = "${aws_globalaccelerator_listener.example.id}"
+}
+`
+}
+
+func testAccGlobalAcceleratorEndpointGroup_update(rName string) string {
+	return testAccGlobalAcceleratorEndpointGroupConfig_base(rName) + `
+resource "aws_eip" "example" {}
+
+resource "aws_globalaccelerator_endpoint_group" "example" {
+  listener_arn = "${aws_globalaccelerator_listener.example.id}"
+
+  endpoint_configuration {
+    endpoint_id = "${aws_eip.example.id}"
+    weight      = 20
+  }
+
+  health_check_interval_seconds = 10
+  health_check_path             = "/foo"
+  health_check_port             = 8080
+  health_check_protocol         = "HTTPS"
+  threshold_count               = 1
+  traffic_dial_percentage       = 50
+}
+`
+}
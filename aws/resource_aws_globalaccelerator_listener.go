@@ -0,0 +1,263 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlobalAcceleratorListener() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlobalAcceleratorListenerCreate,
+		Read:   resourceAwsGlobalAcceleratorListenerRead,
+		Update: resourceAwsGlobalAcceleratorListenerUpdate,
+		Delete: resourceAwsGlobalAcceleratorListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"client_affinity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  globalaccelerator.ClientAffinityNone,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.ClientAffinityNone,
+					globalaccelerator.ClientAffinitySourceIp,
+				}, false),
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					globalaccelerator.ProtocolTcp,
+					globalaccelerator.ProtocolUdp,
+				}, false),
+			},
+			"port_range": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+				Set: resourceAwsGlobalAcceleratorListenerPortRangeHash,
+			},
+		},
+	}
+}
+
+func resourceAwsGlobalAcceleratorListenerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+	acceleratorArn := d.Get("accelerator_arn").(string)
+
+	opts := &globalaccelerator.CreateListenerInput{
+		AcceleratorArn:   aws.String(acceleratorArn),
+		ClientAffinity:   aws.String(d.Get("client_affinity").(string)),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Protocol:         aws.String(d.Get("protocol").(string)),
+		PortRanges:       resourceAwsGlobalAcceleratorListenerExpandPortRanges(d.Get("port_range").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Create Global Accelerator listener: %s", opts)
+
+	resp, err := conn.CreateListener(opts)
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator listener: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Listener.ListenerArn))
+
+	// Creating a listener triggers the accelerator to change status to IN_PROGRESS
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, acceleratorArn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsGlobalAcceleratorListenerRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorListenerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	listener, err := resourceAwsGlobalAcceleratorListenerRetrieve(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator listener (%s): %s", d.Id(), err)
+	}
+
+	if listener == nil {
+		log.Printf("[WARN] Global Accelerator listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	acceleratorArn, err := resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("accelerator_arn", acceleratorArn)
+	d.Set("client_affinity", listener.ClientAffinity)
+	d.Set("protocol", listener.Protocol)
+	if err := d.Set("port_range", resourceAwsGlobalAcceleratorListenerFlattenPortRanges(listener.PortRanges)); err != nil {
+		return fmt.Errorf("error setting port_range: %s", err)
+	}
+
+	return nil
+}
+
+// resourceAwsGlobalAcceleratorListenerParseAcceleratorArn returns the ARN of
+// the accelerator that owns the listener, endpoint group or other child
+// resource with the given ARN.
+func resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(listenerArn string) (string, error) {
+	parsedArn, err := arn.Parse(listenerArn)
+	if err != nil {
+		return "", fmt.Errorf("error parsing Global Accelerator ARN (%s): %s", listenerArn, err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+	if len(parts) < 4 || parts[0] != "accelerator" {
+		return "", fmt.Errorf("unexpected format of Global Accelerator ARN (%s)", listenerArn)
+	}
+
+	parsedArn.Resource = strings.Join(parts[0:2], "/")
+
+	return parsedArn.String(), nil
+}
+
+func resourceAwsGlobalAcceleratorListenerExpandPortRanges(portRanges []interface{}) []*globalaccelerator.PortRange {
+	out := make([]*globalaccelerator.PortRange, len(portRanges))
+
+	for i, raw := range portRanges {
+		portRange := raw.(map[string]interface{})
+		m := globalaccelerator.PortRange{}
+
+		m.FromPort = aws.Int64(int64(portRange["from_port"].(int)))
+		m.ToPort = aws.Int64(int64(portRange["to_port"].(int)))
+
+		out[i] = &m
+	}
+
+	return out
+}
+
+func resourceAwsGlobalAcceleratorListenerFlattenPortRanges(portRanges []*globalaccelerator.PortRange) []interface{} {
+	out := make([]interface{}, len(portRanges))
+
+	for i, portRange := range portRanges {
+		m := make(map[string]interface{})
+
+		m["from_port"] = int(aws.Int64Value(portRange.FromPort))
+		m["to_port"] = int(aws.Int64Value(portRange.ToPort))
+
+		out[i] = m
+	}
+
+	return out
+}
+
+func resourceAwsGlobalAcceleratorListenerRetrieve(conn *globalaccelerator.GlobalAccelerator, listenerArn string) (*globalaccelerator.Listener, error) {
+	resp, err := conn.DescribeListener(&globalaccelerator.DescribeListenerInput{
+		ListenerArn: aws.String(listenerArn),
+	})
+	if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Listener, nil
+}
+
+func resourceAwsGlobalAcceleratorListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.UpdateListenerInput{
+		ClientAffinity: aws.String(d.Get("client_affinity").(string)),
+		ListenerArn:    aws.String(d.Id()),
+		Protocol:       aws.String(d.Get("protocol").(string)),
+		PortRanges:     resourceAwsGlobalAcceleratorListenerExpandPortRanges(d.Get("port_range").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Update Global Accelerator listener: %s", opts)
+
+	_, err := conn.UpdateListener(opts)
+	if err != nil {
+		return fmt.Errorf("error updating Global Accelerator listener (%s): %s", d.Id(), err)
+	}
+
+	// Updating a listener triggers the accelerator to change status to IN_PROGRESS
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Get("accelerator_arn").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAwsGlobalAcceleratorListenerRead(d, meta)
+}
+
+func resourceAwsGlobalAcceleratorListenerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).globalacceleratorconn
+
+	opts := &globalaccelerator.DeleteListenerInput{
+		ListenerArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator listener: %s", opts)
+
+	_, err := conn.DeleteListener(opts)
+	if isAWSErr(err, globalaccelerator.ErrCodeListenerNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator listener (%s): %s", d.Id(), err)
+	}
+
+	// Deleting a listener triggers the accelerator to change status to IN_PROGRESS
+	if err := waitForGlobalAcceleratorAcceleratorDeployment(conn, d.Get("accelerator_arn").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsGlobalAcceleratorListenerPortRangeHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%d-", m["from_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["to_port"].(int)))
+	return hashcode.String(buf.String())
+}
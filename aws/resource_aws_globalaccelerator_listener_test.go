@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsGlobalAcceleratorListenerParseAcceleratorArn(t *testing.T) {
+	cases := []struct {
+		Input         string
+		ExpectedArn   string
+		ExpectedError bool
+	}{
+		{
+			Input:         "",
+			ExpectedError: true,
+		},
+		{
+			Input:         "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+			ExpectedError: true,
+		},
+		{
+			Input:       "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz",
+			ExpectedArn: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+		},
+		{
+			Input:       "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz/endpoint-group/098765zyxwvu",
+			ExpectedArn: "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+		},
+	}
+
+	for _, tc := range cases {
+		arn, err := resourceAwsGlobalAcceleratorListenerParseAcceleratorArn(tc.Input)
+
+		if tc.ExpectedError && err == nil {
+			t.Fatalf("expected error for input %q", tc.Input)
+		}
+
+		if !tc.ExpectedError && err != nil {
+			t.Fatalf("unexpected error for input %q: %s", tc.Input, err)
+		}
+
+		if arn != tc.ExpectedArn {
+			t.Fatalf("expected ARN %q for input %q, got %q", tc.ExpectedArn, tc.Input, arn)
+		}
+	}
+}
+
+func TestAccAwsGlobalAcceleratorListener_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_listener.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlobalAcceleratorListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorListener_basic(rName, "NONE", 80, 81),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorListenerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "accelerator_arn", "aws_globalaccelerator_accelerator.example", "id"),
+					resource.TestCheckResourceAttr(resourceName, "client_affinity", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalAcceleratorListener_basic(rName, "SOURCE_IP", 443, 444),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_affinity", "SOURCE_IP"),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorListenerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		listener, err := resourceAwsGlobalAcceleratorListenerRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if listener == nil {
+			return fmt.Errorf("Global Accelerator listener not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorListenerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_listener" {
+			continue
+		}
+
+		listener, err := resourceAwsGlobalAcceleratorListenerRetrieve(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if listener != nil {
+			return fmt.Errorf("Global Accelerator listener still exists")
+		}
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorListener_basic(rName, clientAffinity string, fromPort, toPort int) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_accelerator" "example" {
+  name            = %q
+  ip_address_type = "IPV4"
+  enabled         = false
+}
+
+resource "aws_globalaccelerator_listener" "example" {
+  accelerator_arn = "${aws_globalaccelerator_accelerator.example.id}"
+  client_affinity = %q
+  protocol        = "TCP"
+
+  port_range {
+    from_port = %d
+    to_port   = %d
+  }
+}
+`, rName, clientAffinity, fromPort, toPort)
+}
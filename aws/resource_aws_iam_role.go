@@ -162,7 +162,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		request.PermissionsBoundary = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		request.Tags = tagsFromMapIAM(v.(map[string]interface{}))
 	}
 
@@ -305,9 +305,9 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMediaConvertQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertQueueCreate,
+		Read:   resourceAwsMediaConvertQueueRead,
+		Update: resourceAwsMediaConvertQueueUpdate,
+		Delete: resourceAwsMediaConvertQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pricing_plan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  mediaconvert.PricingPlanOnDemand,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.PricingPlanOnDemand,
+					mediaconvert.PricingPlanReserved,
+				}, false),
+			},
+			"reservation_plan_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commitment": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.CommitmentOneYear,
+							}, false),
+						},
+						"renewal_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.RenewalTypeAutoRenew,
+								mediaconvert.RenewalTypeExpire,
+							}, false),
+						},
+						"reserved_slots": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  mediaconvert.QueueStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.QueueStatusActive,
+					mediaconvert.QueueStatusPaused,
+				}, false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	input := &mediaconvert.CreateQueueInput{
+		Name:        aws.String(d.Get("name").(string)),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		Tags:        tagsFromMapGeneric(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reservation_plan_settings"); ok {
+		input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Media Convert Queue: %s", input)
+	resp, err := conn.CreateQueue(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Media Convert Queue: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Queue.Name))
+
+	// Queues are created active, so pausing requires an update.
+	if v := d.Get("status").(string); v != mediaconvert.QueueStatusActive {
+		_, err := conn.UpdateQueue(&mediaconvert.UpdateQueueInput{
+			Name:   aws.String(d.Id()),
+			Status: aws.String(v),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Media Convert Queue (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	resp, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Media Convert Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Queue (%s): %s", d.Id(), err)
+	}
+
+	queue := resp.Queue
+
+	d.Set("arn", queue.Arn)
+	d.Set("name", queue.Name)
+	d.Set("description", queue.Description)
+	d.Set("pricing_plan", queue.PricingPlan)
+	d.Set("status", queue.Status)
+
+	if err := d.Set("reservation_plan_settings", flattenMediaConvertReservationPlan(queue.ReservationPlan)); err != nil {
+		return fmt.Errorf("Error setting Media Convert Queue reservation_plan_settings: %s", err)
+	}
+
+	tags, err := tagServiceMediaConvert(conn).List(aws.StringValue(queue.Arn))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("Error setting Media Convert Queue tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("description") || d.HasChange("reservation_plan_settings") || d.HasChange("status") {
+		input := &mediaconvert.UpdateQueueInput{
+			Name:        aws.String(d.Id()),
+			Description: aws.String(d.Get("description").(string)),
+			Status:      aws.String(d.Get("status").(string)),
+		}
+
+		if d.HasChange("reservation_plan_settings") {
+			input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(d.Get("reservation_plan_settings").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Media Convert Queue: %s", input)
+		_, err := conn.UpdateQueue(input)
+		if err != nil {
+			return fmt.Errorf("Error updating Media Convert Queue (%s): %s", d.Id(), err)
+		}
+
+		d.SetPartial("description")
+		d.SetPartial("reservation_plan_settings")
+		d.SetPartial("status")
+	}
+
+	if err := setTagsMediaConvert(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("Error updating Media Convert Queue (%s) tags: %s", d.Id(), err)
+	}
+
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+
+	d.Partial(false)
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return fmt.Errorf("Error getting Media Convert Account Client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Media Convert Queue: %s", d.Id())
+	_, err = conn.DeleteQueue(&mediaconvert.DeleteQueueInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting Media Convert Queue (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getAwsMediaConvertAccountClient returns a MediaConvert client for the
+// account-specific endpoint, which is discovered with DescribeEndpoints on
+// first use and reused afterwards.
+func getAwsMediaConvertAccountClient(awsClient *AWSClient) (*mediaconvert.MediaConvert, error) {
+	const mutexKey = `mediaconvertacctconn`
+	awsMutexKV.Lock(mutexKey)
+	defer awsMutexKV.Unlock(mutexKey)
+
+	if awsClient.mediaconvertacctconn != nil {
+		return awsClient.mediaconvertacctconn, nil
+	}
+
+	input := &mediaconvert.DescribeEndpointsInput{
+		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+	}
+
+	output, err := awsClient.mediaconvertconn.DescribeEndpoints(input)
+	if err != nil {
+		return nil, fmt.Errorf("error describing MediaConvert Endpoints: %s", err)
+	}
+
+	if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || output.Endpoints[0].Url == nil {
+		return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response or URL")
+	}
+
+	endpointURL := aws.StringValue(output.Endpoints[0].Url)
+
+	sess, err := session.NewSession(&awsClient.mediaconvertconn.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS MediaConvert session: %s", err)
+	}
+
+	conn := mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(endpointURL)}))
+
+	awsClient.mediaconvertacctconn = conn
+
+	return conn, nil
+}
+
+func expandMediaConvertReservationPlanSettings(l []interface{}) *mediaconvert.ReservationPlanSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &mediaconvert.ReservationPlanSettings{
+		Commitment:    aws.String(m["commitment"].(string)),
+		RenewalType:   aws.String(m["renewal_type"].(string)),
+		ReservedSlots: aws.Int64(int64(m["reserved_slots"].(int))),
+	}
+}
+
+func flattenMediaConvertReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []interface{} {
+	if reservationPlan == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"commitment":     aws.StringValue(reservationPlan.Commitment),
+		"renewal_type":   aws.StringValue(reservationPlan.RenewalType),
+		"reserved_slots": int(aws.Int64Value(reservationPlan.ReservedSlots)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,782 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Channel states in which the channel is, or is about to be, running.
+var mediaLiveChannelRunningStates = []string{
+	medialive.ChannelStateStarting,
+	medialive.ChannelStateRunning,
+	medialive.ChannelStateRecovering,
+}
+
+func resourceAwsMediaLiveChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelCreate,
+		Read:   resourceAwsMediaLiveChannelRead,
+		Update: resourceAwsMediaLiveChannelUpdate,
+		Delete: resourceAwsMediaLiveChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"settings": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"egress_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"encoder_settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateMediaLiveEncoderSettings,
+				DiffSuppressFunc: suppressEquivalentMediaLiveEncoderSettings,
+			},
+			"input_attachments": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"input_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_settings": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validateMediaLiveInputSettings,
+							DiffSuppressFunc: suppressEquivalentMediaLiveInputSettings,
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputCodecAvc,
+								medialive.InputCodecHevc,
+								medialive.InputCodecMpeg2,
+							}, false),
+						},
+						"maximum_bitrate": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputMaximumBitrateMax10Mbps,
+								medialive.InputMaximumBitrateMax20Mbps,
+								medialive.InputMaximumBitrateMax50Mbps,
+							}, false),
+						},
+						"resolution": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputResolutionSd,
+								medialive.InputResolutionHd,
+								medialive.InputResolutionUhd,
+							}, false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.LogLevelError,
+					medialive.LogLevelWarning,
+					medialive.LogLevelInfo,
+					medialive.LogLevelDebug,
+					medialive.LogLevelDisabled,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_channel": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateChannelInput{
+		Name:               aws.String(d.Get("name").(string)),
+		RequestId:          aws.String(resource.UniqueId()),
+		Destinations:       expandMediaLiveOutputDestinations(d.Get("destinations").(*schema.Set).List()),
+		InputSpecification: expandMediaLiveInputSpecification(d.Get("input_specification").([]interface{})),
+	}
+
+	encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings").(string))
+	if err != nil {
+		return err
+	}
+	input.EncoderSettings = encoderSettings
+
+	inputAttachments, err := expandMediaLiveInputAttachments(d.Get("input_attachments").([]interface{}))
+	if err != nil {
+		return err
+	}
+	input.InputAttachments = inputAttachments
+
+	if v, ok := d.GetOk("log_level"); ok {
+		input.LogLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	resp, err := conn.CreateChannel(input)
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Channel: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Channel.Id))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.ChannelStateCreating},
+		Target:     []string{medialive.ChannelStateIdle},
+		Refresh:    mediaLiveChannelRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) creation: %s", d.Id(), err)
+	}
+
+	if d.Get("start_channel").(bool) {
+		if err := startMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	state := aws.StringValue(resp.State)
+	if state == medialive.ChannelStateDeleted {
+		log.Printf("[WARN] MediaLive Channel (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resp.Arn)
+	d.Set("log_level", resp.LogLevel)
+	d.Set("name", resp.Name)
+	d.Set("role_arn", resp.RoleArn)
+	d.Set("start_channel", mediaLiveChannelStateRunning(state))
+	d.Set("state", state)
+
+	if err := d.Set("destinations", flattenMediaLiveOutputDestinations(resp.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %s", err)
+	}
+
+	egressEndpoints := make([]string, 0, len(resp.EgressEndpoints))
+	for _, e := range resp.EgressEndpoints {
+		egressEndpoints = append(egressEndpoints, aws.StringValue(e.SourceIp))
+	}
+
+	if err := d.Set("egress_endpoints", egressEndpoints); err != nil {
+		return fmt.Errorf("error setting egress_endpoints: %s", err)
+	}
+
+	encoderSettings, err := flattenMediaLiveSettingsJson(resp.EncoderSettings)
+	if err != nil {
+		return fmt.Errorf("error flattening encoder_settings: %s", err)
+	}
+	d.Set("encoder_settings", encoderSettings)
+
+	inputAttachments, err := flattenMediaLiveInputAttachments(resp.InputAttachments)
+	if err != nil {
+		return fmt.Errorf("error flattening input_attachments: %s", err)
+	}
+
+	if err := d.Set("input_attachments", inputAttachments); err != nil {
+		return fmt.Errorf("error setting input_attachments: %s", err)
+	}
+
+	if err := d.Set("input_specification", flattenMediaLiveInputSpecification(resp.InputSpecification)); err != nil {
+		return fmt.Errorf("error setting input_specification: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("destinations") || d.HasChange("encoder_settings") || d.HasChange("input_attachments") ||
+		d.HasChange("input_specification") || d.HasChange("log_level") || d.HasChange("name") || d.HasChange("role_arn") {
+		// Only idle channels can be updated.
+		state, err := mediaLiveChannelState(conn, d.Id())
+		if err != nil {
+			return err
+		}
+
+		if mediaLiveChannelStateRunning(state) {
+			if err := stopMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		input := &medialive.UpdateChannelInput{
+			ChannelId:          aws.String(d.Id()),
+			Name:               aws.String(d.Get("name").(string)),
+			Destinations:       expandMediaLiveOutputDestinations(d.Get("destinations").(*schema.Set).List()),
+			InputSpecification: expandMediaLiveInputSpecification(d.Get("input_specification").([]interface{})),
+		}
+
+		encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings").(string))
+		if err != nil {
+			return err
+		}
+		input.EncoderSettings = encoderSettings
+
+		inputAttachments, err := expandMediaLiveInputAttachments(d.Get("input_attachments").([]interface{}))
+		if err != nil {
+			return err
+		}
+		input.InputAttachments = inputAttachments
+
+		if v, ok := d.GetOk("log_level"); ok {
+			input.LogLevel = aws.String(v.(string))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		if _, err := conn.UpdateChannel(input); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"UPDATING"},
+			Target:     []string{medialive.ChannelStateIdle},
+			Refresh:    mediaLiveChannelRefreshFunc(conn, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 5 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Channel (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	// The channel may have been stopped for the update above, so compare the
+	// desired state with the current one rather than with the prior state.
+	state, err := mediaLiveChannelState(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if start := d.Get("start_channel").(bool); start && !mediaLiveChannelStateRunning(state) {
+		if err := startMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	} else if !start && mediaLiveChannelStateRunning(state) {
+		if err := stopMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	state, err := mediaLiveChannelState(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if state == medialive.ChannelStateDeleted {
+		return nil
+	}
+
+	// Running channels must be stopped before they can be deleted.
+	if mediaLiveChannelStateRunning(state) {
+		if err := stopMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err = conn.DeleteChannel(&medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.ChannelStateDeleting},
+		Target:     []string{medialive.ChannelStateDeleted},
+		Refresh:    mediaLiveChannelRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveChannelRefreshFunc(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+			ChannelId: aws.String(id),
+		})
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return "", medialive.ChannelStateDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return resp, aws.StringValue(resp.State), nil
+	}
+}
+
+func mediaLiveChannelState(conn *medialive.MediaLive, id string) (string, error) {
+	_, state, err := mediaLiveChannelRefreshFunc(conn, id)()
+	if err != nil {
+		return "", fmt.Errorf("error describing MediaLive Channel (%s): %s", id, err)
+	}
+
+	return state, nil
+}
+
+func mediaLiveChannelStateRunning(state string) bool {
+	for _, s := range mediaLiveChannelRunningStates {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+func startMediaLiveChannel(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting MediaLive Channel: %s", id)
+	if _, err := conn.StartChannel(&medialive.StartChannelInput{ChannelId: aws.String(id)}); err != nil {
+		return fmt.Errorf("error starting MediaLive Channel (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.ChannelStateIdle, medialive.ChannelStateStarting},
+		Target:     []string{medialive.ChannelStateRunning},
+		Refresh:    mediaLiveChannelRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to start: %s", id, err)
+	}
+
+	return nil
+}
+
+func stopMediaLiveChannel(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping MediaLive Channel: %s", id)
+	if _, err := conn.StopChannel(&medialive.StopChannelInput{ChannelId: aws.String(id)}); err != nil {
+		return fmt.Errorf("error stopping MediaLive Channel (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.ChannelStateStarting,
+			medialive.ChannelStateRunning,
+			medialive.ChannelStateRecovering,
+			medialive.ChannelStateStopping,
+		},
+		Target:     []string{medialive.ChannelStateIdle},
+		Refresh:    mediaLiveChannelRefreshFunc(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveOutputDestinations(l []interface{}) []*medialive.OutputDestination {
+	destinations := make([]*medialive.OutputDestination, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		destination := &medialive.OutputDestination{
+			Id:       aws.String(m["id"].(string)),
+			Settings: []*medialive.OutputDestinationSettings{},
+		}
+
+		for _, rawSettings := range m["settings"].([]interface{}) {
+			s := rawSettings.(map[string]interface{})
+
+			settings := &medialive.OutputDestinationSettings{}
+
+			if v, ok := s["password_param"].(string); ok && v != "" {
+				settings.PasswordParam = aws.String(v)
+			}
+
+			if v, ok := s["stream_name"].(string); ok && v != "" {
+				settings.StreamName = aws.String(v)
+			}
+
+			if v, ok := s["url"].(string); ok && v != "" {
+				settings.Url = aws.String(v)
+			}
+
+			if v, ok := s["username"].(string); ok && v != "" {
+				settings.Username = aws.String(v)
+			}
+
+			destination.Settings = append(destination.Settings, settings)
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations
+}
+
+func flattenMediaLiveOutputDestinations(destinations []*medialive.OutputDestination) []interface{} {
+	l := make([]interface{}, 0, len(destinations))
+
+	for _, destination := range destinations {
+		settings := make([]interface{}, 0, len(destination.Settings))
+		for _, s := range destination.Settings {
+			settings = append(settings, map[string]interface{}{
+				"password_param": aws.StringValue(s.PasswordParam),
+				"stream_name":    aws.StringValue(s.StreamName),
+				"url":            aws.StringValue(s.Url),
+				"username":       aws.StringValue(s.Username),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"id":       aws.StringValue(destination.Id),
+			"settings": settings,
+		})
+	}
+
+	return l
+}
+
+func expandMediaLiveInputAttachments(l []interface{}) ([]*medialive.InputAttachment, error) {
+	attachments := make([]*medialive.InputAttachment, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		attachment := &medialive.InputAttachment{
+			InputId: aws.String(m["input_id"].(string)),
+		}
+
+		if v, ok := m["input_attachment_name"].(string); ok && v != "" {
+			attachment.InputAttachmentName = aws.String(v)
+		}
+
+		if v, ok := m["input_settings"].(string); ok && v != "" {
+			settings := &medialive.InputSettings{}
+			if err := json.Unmarshal([]byte(v), settings); err != nil {
+				return nil, fmt.Errorf("error decoding input_settings JSON: %s", err)
+			}
+			attachment.InputSettings = settings
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+func flattenMediaLiveInputAttachments(attachments []*medialive.InputAttachment) ([]interface{}, error) {
+	l := make([]interface{}, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		settings := ""
+		if attachment.InputSettings != nil {
+			var err error
+			settings, err = flattenMediaLiveSettingsJson(attachment.InputSettings)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		l = append(l, map[string]interface{}{
+			"input_attachment_name": aws.StringValue(attachment.InputAttachmentName),
+			"input_id":              aws.StringValue(attachment.InputId),
+			"input_settings":        settings,
+		})
+	}
+
+	return l, nil
+}
+
+func expandMediaLiveInputSpecification(l []interface{}) *medialive.InputSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &medialive.InputSpecification{
+		Codec:          aws.String(m["codec"].(string)),
+		MaximumBitrate: aws.String(m["maximum_bitrate"].(string)),
+		Resolution:     aws.String(m["resolution"].(string)),
+	}
+}
+
+func flattenMediaLiveInputSpecification(spec *medialive.InputSpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"codec":           aws.StringValue(spec.Codec),
+		"maximum_bitrate": aws.StringValue(spec.MaximumBitrate),
+		"resolution":      aws.StringValue(spec.Resolution),
+	}
+
+	return []interface{}{m}
+}
+
+func expandMediaLiveEncoderSettings(s string) (*medialive.EncoderSettings, error) {
+	settings := &medialive.EncoderSettings{}
+	if err := json.Unmarshal([]byte(s), settings); err != nil {
+		return nil, fmt.Errorf("error decoding encoder_settings JSON: %s", err)
+	}
+
+	return settings, nil
+}
+
+// flattenMediaLiveSettingsJson encodes MediaLive settings structures using the
+// API field names, omitting unset fields.
+func flattenMediaLiveSettingsJson(settings interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(settings)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func validateMediaLiveEncoderSettings(v interface{}, k string) (ws []string, errors []error) {
+	if err := json.Unmarshal([]byte(v.(string)), &medialive.EncoderSettings{}); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid encoder settings JSON: %s", k, err))
+	}
+
+	return
+}
+
+func validateMediaLiveInputSettings(v interface{}, k string) (ws []string, errors []error) {
+	if err := json.Unmarshal([]byte(v.(string)), &medialive.InputSettings{}); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid input settings JSON: %s", k, err))
+	}
+
+	return
+}
+
+func suppressEquivalentMediaLiveEncoderSettings(k, old, new string, d *schema.ResourceData) bool {
+	return mediaLiveSettingsJsonEquivalent(old, new, func() interface{} { return &medialive.EncoderSettings{} })
+}
+
+func suppressEquivalentMediaLiveInputSettings(k, old, new string, d *schema.ResourceData) bool {
+	return mediaLiveSettingsJsonEquivalent(old, new, func() interface{} { return &medialive.InputSettings{} })
+}
+
+// mediaLiveSettingsJsonEquivalent determines whether the configured settings
+// JSON is satisfied by the settings JSON returned by the API. Both are first
+// normalized through the given SDK structure so that field name casing and
+// ordering do not matter, then settings the API fills in with defaults are
+// ignored unless they are configured.
+func mediaLiveSettingsJsonEquivalent(remote, configured string, newSettings func() interface{}) bool {
+	if remote == "" || configured == "" {
+		return remote == configured
+	}
+
+	remoteValue, err := normalizeMediaLiveSettingsJson(remote, newSettings())
+	if err != nil {
+		return false
+	}
+
+	configuredValue, err := normalizeMediaLiveSettingsJson(configured, newSettings())
+	if err != nil {
+		return false
+	}
+
+	return mediaLiveJsonValueContains(remoteValue, configuredValue)
+}
+
+func normalizeMediaLiveSettingsJson(s string, settings interface{}) (interface{}, error) {
+	if err := json.Unmarshal([]byte(s), settings); err != nil {
+		return nil, err
+	}
+
+	b, err := jsonutil.BuildJSON(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// mediaLiveJsonValueContains returns whether every value in subset is also in
+// value. Lists must have the same length and are compared element-wise.
+func mediaLiveJsonValueContains(value, subset interface{}) bool {
+	switch s := subset.(type) {
+	case map[string]interface{}:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for key, sv := range s {
+			vv, ok := v[key]
+			if !ok || !mediaLiveJsonValueContains(vv, sv) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		v, ok := value.([]interface{})
+		if !ok || len(v) != len(s) {
+			return false
+		}
+
+		for i := range s {
+			if !mediaLiveJsonValueContains(v[i], s[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(value, subset)
+	}
+}
@@ -0,0 +1,316 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMediaLiveSettingsJsonEquivalent(t *testing.T) {
+	newEncoderSettings := func() interface{} { return &medialive.EncoderSettings{} }
+
+	testCases := []struct {
+		name       string
+		remote     string
+		configured string
+		equivalent bool
+	}{
+		{
+			name:       "identical",
+			remote:     `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			configured: `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			equivalent: true,
+		},
+		{
+			name:       "different field name casing",
+			remote:     `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			configured: `{"TimecodeConfig":{"Source":"EMBEDDED"}}`,
+			equivalent: true,
+		},
+		{
+			name:       "remote default",
+			remote:     `{"timecodeConfig":{"source":"EMBEDDED","syncThreshold":1000000}}`,
+			configured: `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			equivalent: true,
+		},
+		{
+			name:       "remote default in list",
+			remote:     `{"videoDescriptions":[{"name":"video_1","respondToAfd":"NONE"}]}`,
+			configured: `{"videoDescriptions":[{"name":"video_1"}]}`,
+			equivalent: true,
+		},
+		{
+			name:       "changed value",
+			remote:     `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			configured: `{"timecodeConfig":{"source":"SYSTEMCLOCK"}}`,
+			equivalent: false,
+		},
+		{
+			name:       "added value",
+			remote:     `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			configured: `{"timecodeConfig":{"source":"EMBEDDED","syncThreshold":1000000}}`,
+			equivalent: false,
+		},
+		{
+			name:       "added list element",
+			remote:     `{"videoDescriptions":[{"name":"video_1"}]}`,
+			configured: `{"videoDescriptions":[{"name":"video_1"},{"name":"video_2"}]}`,
+			equivalent: false,
+		},
+		{
+			name:       "invalid JSON",
+			remote:     `{"timecodeConfig":{"source":"EMBEDDED"}}`,
+			configured: `{"timecodeConfig":`,
+			equivalent: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := mediaLiveSettingsJsonEquivalent(tc.remote, tc.configured, newEncoderSettings); got != tc.equivalent {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.equivalent, got)
+		}
+	}
+}
+
+func TestAccAWSMediaLiveChannel_basic(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveChannelConfig(rName, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", medialive.InputCodecAvc),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.ChannelStateIdle),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaLiveChannelConfig(rName, rName+"-updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.ChannelStateIdle),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveChannel_StartChannel(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveChannelConfig(rName, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.ChannelStateRunning),
+				),
+			},
+			{
+				Config: testAccMediaLiveChannelConfig(rName, rName+"-updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.ChannelStateRunning),
+				),
+			},
+			{
+				Config: testAccMediaLiveChannelConfig(rName, rName+"-updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.ChannelStateIdle),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaLiveChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+			ChannelId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.State) != medialive.ChannelStateDeleted {
+			return fmt.Errorf("MediaLive Channel (%s) not deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaLiveChannelExists(n string, channel *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		resp, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+			ChannelId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*channel = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaLiveChannelConfig(rName, channelName string, startChannel bool) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "medialive.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+}
+
+resource "aws_medialive_channel" "test" {
+  name          = %[2]q
+  role_arn      = "${aws_iam_role.test.arn}"
+  start_channel = %[3]t
+
+  input_specification {
+    codec           = "AVC"
+    maximum_bitrate = "MAX_10_MBPS"
+    resolution      = "HD"
+  }
+
+  input_attachments {
+    input_id = "${aws_medialive_input.test.id}"
+  }
+
+  destinations {
+    id = "destination1"
+
+    settings {
+      url = "udp://203.0.113.1:5000"
+    }
+
+    settings {
+      url = "udp://203.0.113.2:5000"
+    }
+  }
+
+  encoder_settings = <<EOF
+{
+  "audioDescriptions": [
+    {
+      "audioSelectorName": "default",
+      "name": "audio_1"
+    }
+  ],
+  "outputGroups": [
+    {
+      "outputGroupSettings": {
+        "udpGroupSettings": {}
+      },
+      "outputs": [
+        {
+          "audioDescriptionNames": ["audio_1"],
+          "outputName": "output_1",
+          "outputSettings": {
+            "udpOutputSettings": {
+              "containerSettings": {
+                "m2tsSettings": {}
+              },
+              "destination": {
+                "destinationRefId": "destination1"
+              }
+            }
+          },
+          "videoDescriptionName": "video_1"
+        }
+      ]
+    }
+  ],
+  "timecodeConfig": {
+    "source": "EMBEDDED"
+  },
+  "videoDescriptions": [
+    {
+      "name": "video_1"
+    }
+  ]
+}
+EOF
+}
+`, rName, channelName, startChannel)
+}
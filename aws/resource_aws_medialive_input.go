@@ -0,0 +1,447 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMediaLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputCreate,
+		Read:   resourceAwsMediaLiveInputRead,
+		Update: resourceAwsMediaLiveInputUpdate,
+		Delete: resourceAwsMediaLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_channels": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_security_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"media_connect_flows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.InputTypeUdpPush,
+					medialive.InputTypeRtpPush,
+					medialive.InputTypeRtmpPush,
+					medialive.InputTypeRtmpPull,
+					medialive.InputTypeUrlPull,
+					medialive.InputTypeMp4File,
+					medialive.InputTypeMediaconnect,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateInputInput{
+		Name:      aws.String(d.Get("name").(string)),
+		Type:      aws.String(d.Get("type").(string)),
+		RequestId: aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("destinations"); ok {
+		input.Destinations = expandMediaLiveInputDestinations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_security_groups"); ok {
+		input.InputSecurityGroups = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("media_connect_flows"); ok {
+		input.MediaConnectFlows = expandMediaLiveMediaConnectFlows(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sources"); ok {
+		input.Sources = expandMediaLiveInputSources(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input: %s", input)
+	resp, err := conn.CreateInput(input)
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.Input.Id))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.InputStateCreating},
+		Target:     []string{medialive.InputStateDetached, medialive.InputStateAttached},
+		Refresh:    mediaLiveInputRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeInput(&medialive.DescribeInputInput{
+		InputId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaLive Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(resp.State) == medialive.InputStateDeleted {
+		log.Printf("[WARN] MediaLive Input (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resp.Arn)
+	d.Set("name", resp.Name)
+	d.Set("role_arn", resp.RoleArn)
+	d.Set("state", resp.State)
+	d.Set("type", resp.Type)
+
+	if err := d.Set("attached_channels", flattenStringSet(resp.AttachedChannels)); err != nil {
+		return fmt.Errorf("error setting attached_channels: %s", err)
+	}
+
+	// Stream names are only configurable for RTMP push inputs, where the
+	// destination URLs end with them.
+	if aws.StringValue(resp.Type) == medialive.InputTypeRtmpPush {
+		if err := d.Set("destinations", flattenMediaLiveInputDestinationStreamNames(resp.Destinations)); err != nil {
+			return fmt.Errorf("error setting destinations: %s", err)
+		}
+	}
+
+	if err := d.Set("endpoints", flattenMediaLiveInputDestinations(resp.Destinations)); err != nil {
+		return fmt.Errorf("error setting endpoints: %s", err)
+	}
+
+	if err := d.Set("input_security_groups", flattenStringList(resp.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting input_security_groups: %s", err)
+	}
+
+	if err := d.Set("media_connect_flows", flattenMediaLiveMediaConnectFlows(resp.MediaConnectFlows)); err != nil {
+		return fmt.Errorf("error setting media_connect_flows: %s", err)
+	}
+
+	if err := d.Set("sources", flattenMediaLiveInputSources(resp.Sources)); err != nil {
+		return fmt.Errorf("error setting sources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.UpdateInputInput{
+		InputId: aws.String(d.Id()),
+		Name:    aws.String(d.Get("name").(string)),
+	}
+
+	if d.HasChange("destinations") {
+		input.Destinations = expandMediaLiveInputDestinations(d.Get("destinations").([]interface{}))
+	}
+
+	if d.HasChange("input_security_groups") {
+		input.InputSecurityGroups = expandStringList(d.Get("input_security_groups").([]interface{}))
+	}
+
+	if d.HasChange("media_connect_flows") {
+		input.MediaConnectFlows = expandMediaLiveMediaConnectFlows(d.Get("media_connect_flows").([]interface{}))
+	}
+
+	if d.HasChange("role_arn") {
+		input.RoleArn = aws.String(d.Get("role_arn").(string))
+	}
+
+	if d.HasChange("sources") {
+		input.Sources = expandMediaLiveInputSources(d.Get("sources").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating MediaLive Input: %s", input)
+	if _, err := conn.UpdateInput(input); err != nil {
+		return fmt.Errorf("error updating MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input: %s", d.Id())
+	_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+		InputId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{medialive.InputStateDeleting},
+		Target:     []string{medialive.InputStateDeleted},
+		Refresh:    mediaLiveInputRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveInputRefreshFunc(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(id),
+		})
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return "", medialive.InputStateDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return resp, aws.StringValue(resp.State), nil
+	}
+}
+
+func expandMediaLiveInputDestinations(l []interface{}) []*medialive.InputDestinationRequest {
+	destinations := make([]*medialive.InputDestinationRequest, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		destinations = append(destinations, &medialive.InputDestinationRequest{
+			StreamName: aws.String(m["stream_name"].(string)),
+		})
+	}
+
+	return destinations
+}
+
+func flattenMediaLiveInputDestinationStreamNames(destinations []*medialive.InputDestination) []interface{} {
+	l := make([]interface{}, 0, len(destinations))
+
+	for _, destination := range destinations {
+		u, err := url.Parse(aws.StringValue(destination.Url))
+		if err != nil {
+			log.Printf("[WARN] Unable to parse MediaLive Input destination URL %q: %s", aws.StringValue(destination.Url), err)
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"stream_name": strings.TrimPrefix(u.Path, "/"),
+		})
+	}
+
+	return l
+}
+
+func flattenMediaLiveInputDestinations(destinations []*medialive.InputDestination) []interface{} {
+	l := make([]interface{}, 0, len(destinations))
+
+	for _, destination := range destinations {
+		l = append(l, map[string]interface{}{
+			"ip":   aws.StringValue(destination.Ip),
+			"port": aws.StringValue(destination.Port),
+			"url":  aws.StringValue(destination.Url),
+		})
+	}
+
+	return l
+}
+
+func expandMediaLiveMediaConnectFlows(l []interface{}) []*medialive.MediaConnectFlowRequest {
+	flows := make([]*medialive.MediaConnectFlowRequest, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		flows = append(flows, &medialive.MediaConnectFlowRequest{
+			FlowArn: aws.String(m["flow_arn"].(string)),
+		})
+	}
+
+	return flows
+}
+
+func flattenMediaLiveMediaConnectFlows(flows []*medialive.MediaConnectFlow) []interface{} {
+	l := make([]interface{}, 0, len(flows))
+
+	for _, flow := range flows {
+		l = append(l, map[string]interface{}{
+			"flow_arn": aws.StringValue(flow.FlowArn),
+		})
+	}
+
+	return l
+}
+
+func expandMediaLiveInputSources(l []interface{}) []*medialive.InputSourceRequest {
+	sources := make([]*medialive.InputSourceRequest, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		source := &medialive.InputSourceRequest{
+			Url: aws.String(m["url"].(string)),
+		}
+
+		if v, ok := m["password_param"].(string); ok && v != "" {
+			source.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := m["username"].(string); ok && v != "" {
+			source.Username = aws.String(v)
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources
+}
+
+func flattenMediaLiveInputSources(sources []*medialive.InputSource) []interface{} {
+	l := make([]interface{}, 0, len(sources))
+
+	for _, source := range sources {
+		l = append(l, map[string]interface{}{
+			"password_param": aws.StringValue(source.PasswordParam),
+			"url":            aws.StringValue(source.Url),
+			"username":       aws.StringValue(source.Username),
+		})
+	}
+
+	return l
+}
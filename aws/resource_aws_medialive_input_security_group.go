@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputSecurityGroupCreate,
+		Read:   resourceAwsMediaLiveInputSecurityGroupRead,
+		Update: resourceAwsMediaLiveInputSecurityGroupUpdate,
+		Delete: resourceAwsMediaLiveInputSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"whitelist_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandMediaLiveInputWhitelistRules(d.Get("whitelist_rule").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	resp, err := conn.CreateInputSecurityGroup(input)
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.SecurityGroup.Id))
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(resp.State) == medialive.InputSecurityGroupStateDeleted {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resp.Arn)
+
+	if err := d.Set("inputs", flattenStringSet(resp.Inputs)); err != nil {
+		return fmt.Errorf("error setting inputs: %s", err)
+	}
+
+	if err := d.Set("whitelist_rule", flattenMediaLiveInputWhitelistRules(resp.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.UpdateInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+		WhitelistRules:       expandMediaLiveInputWhitelistRules(d.Get("whitelist_rule").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+	if _, err := conn.UpdateInputSecurityGroup(input); err != nil {
+		return fmt.Errorf("error updating MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target: []string{
+			medialive.InputSecurityGroupStateIdle,
+			medialive.InputSecurityGroupStateInUse,
+		},
+		Refresh:    mediaLiveInputSecurityGroupRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input Security Group (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	// Inputs using the security group are deleted asynchronously.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteInputSecurityGroup(input)
+		if isAWSErr(err, medialive.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveInputSecurityGroupRefreshFunc(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(id),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		return resp, aws.StringValue(resp.State), nil
+	}
+}
+
+func expandMediaLiveInputWhitelistRules(l []interface{}) []*medialive.InputWhitelistRuleCidr {
+	rules := make([]*medialive.InputWhitelistRuleCidr, 0, len(l))
+
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		rules = append(rules, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(m["cidr"].(string)),
+		})
+	}
+
+	return rules
+}
+
+func flattenMediaLiveInputWhitelistRules(rules []*medialive.InputWhitelistRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		l = append(l, map[string]interface{}{
+			"cidr": aws.StringValue(rule.Cidr),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaLiveInputSecurityGroup_basic(t *testing.T) {
+	var group medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputSecurityGroupConfig("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputSecurityGroupExists(resourceName, &group),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`inputSecurityGroup:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaLiveInputSecurityGroupConfig("10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputSecurityGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaLiveInputSecurityGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input_security_group" {
+			continue
+		}
+
+		resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.State) != medialive.InputSecurityGroupStateDeleted {
+			return fmt.Errorf("MediaLive Input Security Group (%s) not deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaLiveInputSecurityGroupExists(n string, group *medialive.DescribeInputSecurityGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input Security Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		resp, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*group = *resp
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSMediaLive(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	_, err := conn.ListInputSecurityGroups(&medialive.ListInputSecurityGroupsInput{})
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccMediaLiveInputSecurityGroupConfig(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = %[1]q
+  }
+}
+`, cidr)
+}
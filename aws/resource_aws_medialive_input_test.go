@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaLiveInput_basic(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputConfigRtmpPush(rName, "stream1", "stream2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`input:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attached_channels.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.0.stream_name", "stream1"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.1.stream_name", "stream2"),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.InputStateDetached),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeRtmpPush),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaLiveInputConfigRtmpPush(rName+"-updated", "stream3", "stream4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "destinations.0.stream_name", "stream3"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.1.stream_name", "stream4"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_UrlPull(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputConfigUrlPull(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.url", "https://example.com/primary/index.m3u8"),
+					resource.TestCheckResourceAttr(resourceName, "sources.1.url", "https://example.com/secondary/index.m3u8"),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeUrlPull),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaLiveInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input" {
+			continue
+		}
+
+		resp, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.State) != medialive.InputStateDeleted {
+			return fmt.Errorf("MediaLive Input (%s) not deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaLiveInputExists(n string, input *medialive.DescribeInputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		resp, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*input = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaLiveInputConfigRtmpPush(rName, streamName1, streamName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "RTMP_PUSH"
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+
+  destinations {
+    stream_name = %[2]q
+  }
+
+  destinations {
+    stream_name = %[3]q
+  }
+}
+`, rName, streamName1, streamName2)
+}
+
+func testAccMediaLiveInputConfigUrlPull(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  sources {
+    url = "https://example.com/primary/index.m3u8"
+  }
+
+  sources {
+    url = "https://example.com/secondary/index.m3u8"
+  }
+}
+`, rName)
+}
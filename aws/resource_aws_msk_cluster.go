@@ -0,0 +1,374 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsMskCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMskClusterCreate,
+		Read:   resourceAwsMskClusterRead,
+		Delete: resourceAwsMskClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"broker_node_group_info": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az_distribution": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  kafka.BrokerAZDistributionDefault,
+							ValidateFunc: validation.StringInSlice([]string{
+								kafka.BrokerAZDistributionDefault,
+							}, false),
+						},
+						"client_subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ebs_volume_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 16384),
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"current_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_at_rest_kms_key_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"enhanced_monitoring": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  kafka.EnhancedMonitoringDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					kafka.EnhancedMonitoringDefault,
+					kafka.EnhancedMonitoringPerBroker,
+					kafka.EnhancedMonitoringPerTopicPerBroker,
+				}, false),
+			},
+			"kafka_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"number_of_broker_nodes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"zookeeper_connect_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsMskClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+	name := d.Get("cluster_name").(string)
+
+	input := &kafka.CreateClusterInput{
+		BrokerNodeGroupInfo: expandMskClusterBrokerNodeGroupInfo(d.Get("broker_node_group_info").([]interface{})),
+		ClusterName:         aws.String(name),
+		EncryptionInfo:      expandMskClusterEncryptionInfo(d.Get("encryption_info").([]interface{})),
+		EnhancedMonitoring:  aws.String(d.Get("enhanced_monitoring").(string)),
+		KafkaVersion:        aws.String(d.Get("kafka_version").(string)),
+		NumberOfBrokerNodes: aws.Int64(int64(d.Get("number_of_broker_nodes").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating MSK Cluster: %s", input)
+	output, err := conn.CreateCluster(input)
+	if err != nil {
+		return fmt.Errorf("error creating MSK Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ClusterArn))
+
+	if err := waitForMskClusterCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MSK Cluster (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMskClusterRead(d, meta)
+}
+
+func resourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MSK Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s): %s", d.Id(), err)
+	}
+
+	cluster := output.ClusterInfo
+	if cluster == nil {
+		log.Printf("[WARN] MSK Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	brokerOutput, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: cluster.ClusterArn,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Cluster (%s) bootstrap brokers: %s", d.Id(), err)
+	}
+
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("bootstrap_brokers", brokerOutput.BootstrapBrokerString)
+
+	if err := d.Set("broker_node_group_info", flattenMskClusterBrokerNodeGroupInfo(cluster.BrokerNodeGroupInfo)); err != nil {
+		return fmt.Errorf("error setting broker_node_group_info: %s", err)
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+	d.Set("current_version", cluster.CurrentVersion)
+
+	if err := d.Set("encryption_info", flattenMskClusterEncryptionInfo(cluster.EncryptionInfo)); err != nil {
+		return fmt.Errorf("error setting encryption_info: %s", err)
+	}
+
+	d.Set("enhanced_monitoring", cluster.EnhancedMonitoring)
+
+	if cluster.CurrentBrokerSoftwareInfo != nil {
+		d.Set("kafka_version", cluster.CurrentBrokerSoftwareInfo.KafkaVersion)
+	}
+
+	d.Set("number_of_broker_nodes", cluster.NumberOfBrokerNodes)
+	d.Set("zookeeper_connect_string", cluster.ZookeeperConnectString)
+
+	return nil
+}
+
+func resourceAwsMskClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kafkaconn
+
+	log.Printf("[DEBUG] Deleting MSK Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForMskClusterDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MSK Cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMskClusterBrokerNodeGroupInfo(l []interface{}) *kafka.BrokerNodeGroupInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &kafka.BrokerNodeGroupInfo{
+		BrokerAZDistribution: aws.String(m["az_distribution"].(string)),
+		ClientSubnets:        expandStringSet(m["client_subnets"].(*schema.Set)),
+		InstanceType:         aws.String(m["instance_type"].(string)),
+		SecurityGroups:       expandStringSet(m["security_groups"].(*schema.Set)),
+		StorageInfo: &kafka.StorageInfo{
+			EbsStorageInfo: &kafka.EBSStorageInfo{
+				VolumeSize: aws.Int64(int64(m["ebs_volume_size"].(int))),
+			},
+		},
+	}
+}
+
+func expandMskClusterEncryptionInfo(l []interface{}) *kafka.EncryptionInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	v, ok := m["encryption_at_rest_kms_key_arn"].(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	return &kafka.EncryptionInfo{
+		EncryptionAtRest: &kafka.EncryptionAtRest{
+			DataVolumeKMSKeyId: aws.String(v),
+		},
+	}
+}
+
+func flattenMskClusterBrokerNodeGroupInfo(info *kafka.BrokerNodeGroupInfo) []map[string]interface{} {
+	if info == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"az_distribution": aws.StringValue(info.BrokerAZDistribution),
+		"client_subnets":  schema.NewSet(schema.HashString, flattenStringList(info.ClientSubnets)),
+		"instance_type":   aws.StringValue(info.InstanceType),
+		"security_groups": schema.NewSet(schema.HashString, flattenStringList(info.SecurityGroups)),
+	}
+
+	if info.StorageInfo != nil && info.StorageInfo.EbsStorageInfo != nil {
+		m["ebs_volume_size"] = int(aws.Int64Value(info.StorageInfo.EbsStorageInfo.VolumeSize))
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenMskClusterEncryptionInfo(info *kafka.EncryptionInfo) []map[string]interface{} {
+	if info == nil || info.EncryptionAtRest == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"encryption_at_rest_kms_key_arn": aws.StringValue(info.EncryptionAtRest.DataVolumeKMSKeyId),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func refreshMskClusterState(conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ClusterInfo == nil {
+			return nil, "", fmt.Errorf("MSK Cluster (%s) missing", arn)
+		}
+
+		return output.ClusterInfo, aws.StringValue(output.ClusterInfo.State), nil
+	}
+}
+
+func waitForMskClusterCreation(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateCreating},
+		Target:  []string{kafka.ClusterStateActive},
+		Refresh: refreshMskClusterState(conn, arn),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForMskClusterDeletion(conn *kafka.Kafka, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			kafka.ClusterStateActive,
+			kafka.ClusterStateCreating,
+			kafka.ClusterStateDeleting,
+			kafka.ClusterStateFailed,
+		},
+		Target:  []string{},
+		Refresh: refreshMskClusterState(conn, arn),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	return err
+}
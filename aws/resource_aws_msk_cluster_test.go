@@ -0,0 +1,326 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
+		Name: "aws_msk_cluster",
+		F:    testSweepMskClusters,
+	})
+}
+
+func testSweepMskClusters(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).kafkaconn
+
+	input := &kafka.ListClustersInput{}
+	for {
+		out, err := conn.ListClusters(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping MSK Clusters sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving MSK Clusters: %s", err)
+		}
+
+		for _, cluster := range out.ClusterInfoList {
+			name := aws.StringValue(cluster.ClusterName)
+			arn := aws.StringValue(cluster.ClusterArn)
+
+			if !strings.HasPrefix(name, "tf-acc-test-") {
+				log.Printf("[INFO] Skipping MSK Cluster: %s", name)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting MSK Cluster: %s", name)
+			_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+				ClusterArn: aws.String(arn),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete MSK Cluster %s: %s", name, err)
+				continue
+			}
+			err = waitForMskClusterDeletion(conn, arn, 60*time.Minute)
+			if err != nil {
+				log.Printf("[ERROR] Failed to wait for MSK Cluster %s deletion: %s", name, err)
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSMskCluster_basic(t *testing.T) {
+	var cluster kafka.ClusterInfo
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMskClusterConfig_Required(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMskClusterExists(resourceName, &cluster),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf("^arn:[^:]+:kafka:[^:]+:[^:]+:cluster/%s/.+$", rName))),
+					resource.TestMatchResourceAttr(resourceName, "bootstrap_brokers", regexp.MustCompile(`^(([-\w]+\.){1,}[\w]+:\d+,){2,}([-\w]+\.){1,}[\w]+:\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.az_distribution", kafka.BrokerAZDistributionDefault),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.client_subnets.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.ebs_volume_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.instance_type", "kafka.m5.large"),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "current_version"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_info.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "encryption_info.0.encryption_at_rest_kms_key_arn", regexp.MustCompile(`^arn:[^:]+:kms:[^:]+:[^:]+:key/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_monitoring", kafka.EnhancedMonitoringDefault),
+					resource.TestCheckResourceAttr(resourceName, "kafka_version", "1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_broker_nodes", "3"),
+					resource.TestMatchResourceAttr(resourceName, "zookeeper_connect_string", regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+:\d+,\d+\.\d+\.\d+\.\d+:\d+,\d+\.\d+\.\d+\.\d+:\d+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_EncryptionInfo(t *testing.T) {
+	var cluster kafka.ClusterInfo
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMskClusterConfig_EncryptionInfo(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMskClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "encryption_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_info.0.encryption_at_rest_kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMskCluster_EnhancedMonitoring(t *testing.T) {
+	var cluster kafka.ClusterInfo
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMskClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMskClusterConfig_EnhancedMonitoring(rName, kafka.EnhancedMonitoringPerBroker),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMskClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_monitoring", kafka.EnhancedMonitoringPerBroker),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMskClusterExists(resourceName string, cluster *kafka.ClusterInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Cluster ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.ClusterInfo == nil {
+			return fmt.Errorf("MSK Cluster (%s) not found", rs.Primary.ID)
+		}
+
+		*cluster = *output.ClusterInfo
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMskClusterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_msk_cluster" {
+			continue
+		}
+
+		output, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
+			ClusterArn: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kafka.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.ClusterInfo != nil {
+			return fmt.Errorf("MSK Cluster (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSMskClusterConfig_Base() string {
+	return `
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "192.168.0.0/22"
+
+  tags = {
+    Name = "terraform-testacc-msk-cluster"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 3
+
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  cidr_block        = "192.168.${count.index}.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = "terraform-testacc-msk-cluster"
+  }
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.test.id}"
+}
+`
+}
+
+func testAccAWSMskClusterConfig_Required(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = "%s"
+  kafka_version          = "1.1.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.test.*.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.test.id}"]
+  }
+}
+`, testAccAWSMskClusterConfig_Base(), rName)
+}
+
+func testAccAWSMskClusterConfig_EncryptionInfo(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_kms_key" "test" {
+  description = "%s"
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = "%s"
+  kafka_version          = "1.1.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.test.*.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.test.id}"]
+  }
+
+  encryption_info {
+    encryption_at_rest_kms_key_arn = "${aws_kms_key.test.arn}"
+  }
+}
+`, testAccAWSMskClusterConfig_Base(), rName, rName)
+}
+
+func testAccAWSMskClusterConfig_EnhancedMonitoring(rName, enhancedMonitoring string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = "%s"
+  enhanced_monitoring    = "%s"
+  kafka_version          = "1.1.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = ["${aws_subnet.test.*.id}"]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = ["${aws_security_group.test.id}"]
+  }
+}
+`, testAccAWSMskClusterConfig_Base(), rName, enhancedMonitoring)
+}
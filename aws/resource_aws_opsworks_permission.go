@@ -18,6 +18,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 		Update: resourceAwsOpsworksSetPermission,
 		Delete: resourceAwsOpsworksPermissionDelete,
 		Read:   resourceAwsOpsworksPermissionRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allow_ssh": {
@@ -106,6 +109,18 @@ func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceAwsOpsworksPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	userArn, stackId, err := parseOpsworksStackScopedId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("user_arn", userArn)
+	d.Set("stack_id", stackId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksSetPermission(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).opsworksconn
 
@@ -37,6 +37,11 @@ func TestAccAWSOpsworksPermission(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_permission.tf-acc-perm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAwsOpsworksPermissionCreate(sName, "true", "false", "iam_only"),
 				Check: resource.ComposeTestCheckFunc(
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -603,3 +604,16 @@ func resourceAwsOpsworksStackDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+var opsworksStackScopedIdRegexp = regexp.MustCompile(`^(arn:.+)([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+
+// parseOpsworksStackScopedId splits the ID used by resources that associate
+// an ARN with a stack, which is the ARN immediately followed by the stack ID.
+func parseOpsworksStackScopedId(id string) (string, string, error) {
+	parts := opsworksStackScopedIdRegexp.FindStringSubmatch(id)
+	if parts == nil {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected ARN immediately followed by STACK-ID", id)
+	}
+
+	return parts[1], parts[2], nil
+}
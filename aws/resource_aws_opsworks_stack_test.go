@@ -13,6 +13,53 @@ import (
 	"github.com/aws/aws-sdk-go/service/opsworks"
 )
 
+func TestParseOpsworksStackScopedId(t *testing.T) {
+	testCases := []struct {
+		Id          string
+		ExpectedArn string
+		ExpectedId  string
+		ExpectError bool
+	}{
+		{
+			Id:          "arn:aws:iam::123456789012:user/tf-acc-test6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			ExpectedArn: "arn:aws:iam::123456789012:user/tf-acc-test",
+			ExpectedId:  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		{
+			Id:          "arn:aws:rds:us-west-2:123456789012:db:tf-acc-test6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			ExpectedArn: "arn:aws:rds:us-west-2:123456789012:db:tf-acc-test",
+			ExpectedId:  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		{
+			Id:          "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			ExpectError: true,
+		},
+		{
+			Id:          "arn:aws:iam::123456789012:user/tf-acc-test",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		arn, id, err := parseOpsworksStackScopedId(tc.Id)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected error for ID %q", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for ID %q: %s", tc.Id, err)
+		}
+		if arn != tc.ExpectedArn {
+			t.Fatalf("expected ARN %q, got %q", tc.ExpectedArn, arn)
+		}
+		if id != tc.ExpectedId {
+			t.Fatalf("expected stack ID %q, got %q", tc.ExpectedId, id)
+		}
+	}
+}
+
 func TestAccAWSOpsworksStackImportBasic(t *testing.T) {
 	name := acctest.RandString(10)
 
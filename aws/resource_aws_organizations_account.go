@@ -17,6 +17,7 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsOrganizationsAccountCreate,
 		Read:   resourceAwsOrganizationsAccountRead,
+		Update: resourceAwsOrganizationsAccountUpdate,
 		Delete: resourceAwsOrganizationsAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -51,6 +52,12 @@ func resourceAwsOrganizationsAccount() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateAwsOrganizationsAccountEmail,
 			},
+			"parent_id": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateAwsOrganizationsParentId,
+			},
 			"iam_user_access_to_billing": {
 				ForceNew:     true,
 				Type:         schema.TypeString,
@@ -130,6 +137,21 @@ func resourceAwsOrganizationsAccountCreate(d *schema.ResourceData, meta interfac
 	accountId := stateResp.(*organizations.CreateAccountStatus).AccountId
 	d.SetId(*accountId)
 
+	if v, ok := d.GetOk("parent_id"); ok {
+		newParentId := v.(string)
+
+		existingParentId, err := resourceAwsOrganizationsOrganizationalUnitGetParentId(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error getting AWS Organizations Account (%s) parent: %s", d.Id(), err)
+		}
+
+		if newParentId != existingParentId {
+			if err := resourceAwsOrganizationsAccountMove(conn, d.Id(), existingParentId, newParentId); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceAwsOrganizationsAccountRead(d, meta)
 }
 
@@ -155,15 +177,35 @@ func resourceAwsOrganizationsAccountRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
+	parentId, err := resourceAwsOrganizationsOrganizationalUnitGetParentId(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting AWS Organizations Account (%s) parent: %s", d.Id(), err)
+	}
+
 	d.Set("arn", account.Arn)
 	d.Set("email", account.Email)
 	d.Set("joined_method", account.JoinedMethod)
 	d.Set("joined_timestamp", account.JoinedTimestamp)
 	d.Set("name", account.Name)
+	d.Set("parent_id", parentId)
 	d.Set("status", account.Status)
 	return nil
 }
 
+func resourceAwsOrganizationsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	if d.HasChange("parent_id") {
+		o, n := d.GetChange("parent_id")
+
+		if err := resourceAwsOrganizationsAccountMove(conn, d.Id(), o.(string), n.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsOrganizationsAccountRead(d, meta)
+}
+
 func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).organizationsconn
 
@@ -181,6 +223,23 @@ func resourceAwsOrganizationsAccountDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// resourceAwsOrganizationsAccountMove moves an account from one root or
+// organizational unit to another.
+func resourceAwsOrganizationsAccountMove(conn *organizations.Organizations, accountId, sourceParentId, destinationParentId string) error {
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(accountId),
+		SourceParentId:      aws.String(sourceParentId),
+		DestinationParentId: aws.String(destinationParentId),
+	}
+
+	log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
+	if _, err := conn.MoveAccount(input); err != nil {
+		return fmt.Errorf("error moving AWS Organizations Account (%s) from %q to %q: %s", accountId, sourceParentId, destinationParentId, err)
+	}
+
+	return nil
+}
+
 // resourceAwsOrganizationsAccountStateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a CreateAccount request
 func resourceAwsOrganizationsAccountStateRefreshFunc(conn *organizations.Organizations, id string) resource.StateRefreshFunc {
@@ -12,7 +12,17 @@ func TestAccAWSOrganizations(t *testing.T) {
 			"FeatureSet":                 testAccAwsOrganizationsOrganization_FeatureSet,
 		},
 		"Account": {
-			"basic": testAccAwsOrganizationsAccount_basic,
+			"basic":    testAccAwsOrganizationsAccount_basic,
+			"ParentId": testAccAwsOrganizationsAccount_ParentId,
+		},
+		"OrganizationalUnit": {
+			"basic":  testAccAwsOrganizationsOrganizationalUnit_basic,
+			"Name":   testAccAwsOrganizationsOrganizationalUnit_Name,
+			"Nested": testAccAwsOrganizationsOrganizationalUnit_Nested,
+		},
+		"DataSource": {
+			"Accounts":            testAccDataSourceAwsOrganizationsAccounts_basic,
+			"OrganizationalUnits": testAccDataSourceAwsOrganizationsOrganizationalUnits_basic,
 		},
 	}
 
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsRamPrincipalAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamPrincipalAssociationCreate,
+		Read:   resourceAwsRamPrincipalAssociationRead,
+		Delete: resourceAwsRamPrincipalAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsRamPrincipalAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	principal := d.Get("principal").(string)
+	resourceShareARN := d.Get("resource_share_arn").(string)
+
+	input := &ram.AssociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       aws.StringSlice([]string{principal}),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	log.Printf("[DEBUG] Associating RAM resource share with principal: %s", input)
+	_, err := conn.AssociateResourceShare(input)
+	if err != nil {
+		return fmt.Errorf("error associating RAM resource share (%s) with principal (%s): %s", resourceShareARN, principal, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", resourceShareARN, principal))
+
+	err = waitForRamResourceShareAssociationStatus(conn, resourceShareARN, ram.ResourceShareAssociationTypePrincipal, principal, d.Timeout(schema.TimeoutCreate),
+		[]string{ram.ResourceShareAssociationStatusAssociating},
+		[]string{ram.ResourceShareAssociationStatusAssociated})
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) principal association (%s): %s", resourceShareARN, principal, err)
+	}
+
+	return resourceAwsRamPrincipalAssociationRead(d, meta)
+}
+
+func resourceAwsRamPrincipalAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareARN, principal, err := decodeRamPrincipalAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, status, err := ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, ram.ResourceShareAssociationTypePrincipal, principal)()
+	if err != nil {
+		return fmt.Errorf("error reading RAM resource share (%s) principal association (%s): %s", resourceShareARN, principal, err)
+	}
+
+	if status == ram.ResourceShareAssociationStatusDisassociated {
+		log.Printf("[WARN] RAM resource share (%s) principal association (%s) not found, removing from state", resourceShareARN, principal)
+		d.SetId("")
+		return nil
+	}
+
+	if status != ram.ResourceShareAssociationStatusAssociated {
+		return fmt.Errorf("error reading RAM resource share (%s) principal association (%s): invalid status %s", resourceShareARN, principal, status)
+	}
+
+	d.Set("principal", principal)
+	d.Set("resource_share_arn", resourceShareARN)
+
+	return nil
+}
+
+func resourceAwsRamPrincipalAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareARN, principal, err := decodeRamPrincipalAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       aws.StringSlice([]string{principal}),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	log.Printf("[DEBUG] Disassociating RAM resource share from principal: %s", input)
+	_, err = conn.DisassociateResourceShare(input)
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disassociating RAM resource share (%s) from principal (%s): %s", resourceShareARN, principal, err)
+	}
+
+	err = waitForRamResourceShareAssociationStatus(conn, resourceShareARN, ram.ResourceShareAssociationTypePrincipal, principal, d.Timeout(schema.TimeoutDelete),
+		[]string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusDisassociating},
+		[]string{ram.ResourceShareAssociationStatusDisassociated})
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) principal disassociation (%s): %s", resourceShareARN, principal, err)
+	}
+
+	return nil
+}
+
+func decodeRamPrincipalAssociationID(id string) (string, string, error) {
+	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected SHARE,PRINCIPAL", id)
+
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", idFormatErr
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsRamPrincipalAssociation_basic(t *testing.T) {
+	var association ram.ResourceShareAssociation
+	resourceName := "aws_ram_principal_association.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRam(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamPrincipalAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamPrincipalAssociationConfig_basic(rName, "111111111111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamPrincipalAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "principal", "111111111111"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", "aws_ram_resource_share.example", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamPrincipalAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_principal_association" {
+			continue
+		}
+
+		resourceShareARN, principal, err := decodeRamPrincipalAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, status, err := ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, ram.ResourceShareAssociationTypePrincipal, principal)()
+		if err != nil {
+			return err
+		}
+
+		if status != ram.ResourceShareAssociationStatusDisassociated {
+			return fmt.Errorf("RAM resource share (%s) principal association (%s) still exists with status %s", resourceShareARN, principal, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsRamPrincipalAssociationExists(n string, association *ram.ResourceShareAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM principal association ID is set")
+		}
+
+		resourceShareARN, principal, err := decodeRamPrincipalAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		raw, status, err := ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, ram.ResourceShareAssociationTypePrincipal, principal)()
+		if err != nil {
+			return err
+		}
+
+		if status != ram.ResourceShareAssociationStatusAssociated {
+			return fmt.Errorf("RAM resource share (%s) principal association (%s) has status %s", resourceShareARN, principal, status)
+		}
+
+		*association = *raw.(*ram.ResourceShareAssociation)
+
+		return nil
+	}
+}
+
+func testAccAwsRamPrincipalAssociationConfig_basic(rName, principal string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "example" {
+  allow_external_principals = true
+  name                      = %q
+}
+
+resource "aws_ram_principal_association" "example" {
+  principal          = %q
+  resource_share_arn = "${aws_ram_resource_share.example.id}"
+}
+`, rName, principal)
+}
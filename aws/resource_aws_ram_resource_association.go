@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceAssociationCreate,
+		Read:   resourceAwsRamResourceAssociationRead,
+		Delete: resourceAwsRamResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsRamResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	resourceARN := d.Get("resource_arn").(string)
+	resourceShareARN := d.Get("resource_share_arn").(string)
+
+	input := &ram.AssociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ResourceArns:     aws.StringSlice([]string{resourceARN}),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	log.Printf("[DEBUG] Associating RAM resource share: %s", input)
+	_, err := conn.AssociateResourceShare(input)
+	if err != nil {
+		return fmt.Errorf("error associating RAM resource share: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", resourceShareARN, resourceARN))
+
+	err = waitForRamResourceShareAssociationStatus(conn, resourceShareARN, ram.ResourceShareAssociationTypeResource, resourceARN, d.Timeout(schema.TimeoutCreate),
+		[]string{ram.ResourceShareAssociationStatusAssociating},
+		[]string{ram.ResourceShareAssociationStatusAssociated})
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) resource association (%s): %s", resourceShareARN, resourceARN, err)
+	}
+
+	return resourceAwsRamResourceAssociationRead(d, meta)
+}
+
+func resourceAwsRamResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareARN, resourceARN, err := decodeRamResourceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	_, status, err := ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, ram.ResourceShareAssociationTypeResource, resourceARN)()
+	if err != nil {
+		return fmt.Errorf("error reading RAM resource share (%s) resource association (%s): %s", resourceShareARN, resourceARN, err)
+	}
+
+	if status == ram.ResourceShareAssociationStatusDisassociated {
+		log.Printf("[WARN] RAM resource share (%s) resource association (%s) not found, removing from state", resourceShareARN, resourceARN)
+		d.SetId("")
+		return nil
+	}
+
+	if status != ram.ResourceShareAssociationStatusAssociated {
+		return fmt.Errorf("error reading RAM resource share (%s) resource association (%s): invalid status %s", resourceShareARN, resourceARN, status)
+	}
+
+	d.Set("resource_arn", resourceARN)
+	d.Set("resource_share_arn", resourceShareARN)
+
+	return nil
+}
+
+func resourceAwsRamResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	resourceShareARN, resourceARN, err := decodeRamResourceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ResourceArns:     aws.StringSlice([]string{resourceARN}),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	log.Printf("[DEBUG] Disassociating RAM resource share: %s", input)
+	_, err = conn.DisassociateResourceShare(input)
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error disassociating RAM resource share (%s) resource (%s): %s", resourceShareARN, resourceARN, err)
+	}
+
+	err = waitForRamResourceShareAssociationStatus(conn, resourceShareARN, ram.ResourceShareAssociationTypeResource, resourceARN, d.Timeout(schema.TimeoutDelete),
+		[]string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusDisassociating},
+		[]string{ram.ResourceShareAssociationStatusDisassociated})
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) resource disassociation (%s): %s", resourceShareARN, resourceARN, err)
+	}
+
+	return nil
+}
+
+func decodeRamResourceAssociationID(id string) (string, string, error) {
+	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected SHARE,RESOURCE", id)
+
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", idFormatErr
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ramResourceShareAssociationRefreshFunc returns the association of the
+// resource share with the resource or principal. A disassociated status is
+// returned once the association no longer exists.
+func ramResourceShareAssociationRefreshFunc(conn *ram.RAM, resourceShareARN, associationType, associatedEntity string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ram.GetResourceShareAssociationsInput{
+			AssociationType:   aws.String(associationType),
+			ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+		}
+
+		if associationType == ram.ResourceShareAssociationTypePrincipal {
+			input.Principal = aws.String(associatedEntity)
+		} else {
+			input.ResourceArn = aws.String(associatedEntity)
+		}
+
+		output, err := conn.GetResourceShareAssociations(input)
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			return 42, ram.ResourceShareAssociationStatusDisassociated, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, association := range output.ResourceShareAssociations {
+			if aws.StringValue(association.AssociatedEntity) == associatedEntity {
+				return association, aws.StringValue(association.Status), nil
+			}
+		}
+
+		return 42, ram.ResourceShareAssociationStatusDisassociated, nil
+	}
+}
+
+func waitForRamResourceShareAssociationStatus(conn *ram.RAM, resourceShareARN, associationType, associatedEntity string, timeout time.Duration, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, associationType, associatedEntity),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsRamResourceAssociation_basic(t *testing.T) {
+	var association ram.ResourceShareAssociation
+	resourceName := "aws_ram_resource_association.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRam(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceAssociationExists(resourceName, &association),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_ec2_transit_gateway.example", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_share_arn", "aws_ram_resource_share.example", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamResourceAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_association" {
+			continue
+		}
+
+		resourceShareARN, resourceARN, err := decodeRamResourceAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, status, err := ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, ram.ResourceShareAssociationTypeResource, resourceARN)()
+		if err != nil {
+			return err
+		}
+
+		if status != ram.ResourceShareAssociationStatusDisassociated {
+			return fmt.Errorf("RAM resource share (%s) resource association (%s) still exists with status %s", resourceShareARN, resourceARN, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsRamResourceAssociationExists(n string, association *ram.ResourceShareAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM resource association ID is set")
+		}
+
+		resourceShareARN, resourceARN, err := decodeRamResourceAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		raw, status, err := ramResourceShareAssociationRefreshFunc(conn, resourceShareARN, ram.ResourceShareAssociationTypeResource, resourceARN)()
+		if err != nil {
+			return err
+		}
+
+		if status != ram.ResourceShareAssociationStatusAssociated {
+			return fmt.Errorf("RAM resource share (%s) resource association (%s) has status %s", resourceShareARN, resourceARN, status)
+		}
+
+		*association = *raw.(*ram.ResourceShareAssociation)
+
+		return nil
+	}
+}
+
+func testAccAwsRamResourceAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "example" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_resource_share" "example" {
+  name = %[1]q
+}
+
+resource "aws_ram_resource_association" "example" {
+  resource_arn       = "${aws_ec2_transit_gateway.example.arn}"
+  resource_share_arn = "${aws_ram_resource_share.example.id}"
+}
+`, rName)
+}
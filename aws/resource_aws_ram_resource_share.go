@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceShareCreate,
+		Read:   resourceAwsRamResourceShareRead,
+		Update: resourceAwsRamResourceShareUpdate,
+		Delete: resourceAwsRamResourceShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allow_external_principals": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	request := &ram.CreateResourceShareInput{
+		AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
+		ClientToken:             aws.String(resource.UniqueId()),
+		Name:                    aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		request.Tags = tagsFromMapRAM(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating RAM resource share: %s", request)
+	createResp, err := conn.CreateResourceShare(request)
+	if err != nil {
+		return fmt.Errorf("error creating RAM resource share: %s", err)
+	}
+
+	d.SetId(aws.StringValue(createResp.ResourceShare.ResourceShareArn))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusPending},
+		Target:  []string{ram.ResourceShareStatusActive},
+		Refresh: resourceAwsRamResourceShareStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsRamResourceShareRead(d, meta)
+}
+
+func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	request := &ram.GetResourceSharesInput{
+		ResourceShareArns: []*string{aws.String(d.Id())},
+		ResourceOwner:     aws.String(ram.ResourceOwnerSelf),
+	}
+
+	output, err := conn.GetResourceShares(request)
+	if err != nil {
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			log.Printf("[WARN] RAM resource share (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading RAM resource share (%s): %s", d.Id(), err)
+	}
+
+	if len(output.ResourceShares) == 0 {
+		log.Printf("[WARN] RAM resource share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	resourceShare := output.ResourceShares[0]
+
+	if aws.StringValue(resourceShare.Status) != ram.ResourceShareStatusActive {
+		log.Printf("[WARN] RAM resource share (%s) has status %s, removing from state", d.Id(), aws.StringValue(resourceShare.Status))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resourceShare.ResourceShareArn)
+	d.Set("name", resourceShare.Name)
+	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
+
+	if err := d.Set("tags", tagsToMapRAM(resourceShare.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	d.Partial(true)
+
+	if d.HasChange("name") || d.HasChange("allow_external_principals") {
+		request := &ram.UpdateResourceShareInput{
+			AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
+			ClientToken:             aws.String(resource.UniqueId()),
+			Name:                    aws.String(d.Get("name").(string)),
+			ResourceShareArn:        aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating RAM resource share: %s", request)
+		_, err := conn.UpdateResourceShare(request)
+		if err != nil {
+			if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+				log.Printf("[WARN] RAM resource share (%s) not found, removing from state", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("error updating RAM resource share (%s): %s", d.Id(), err)
+		}
+
+		d.SetPartial("name")
+		d.SetPartial("allow_external_principals")
+	}
+
+	if d.HasChange("tags_all") {
+		if err := setTagsRAM(conn, d); err != nil {
+			return fmt.Errorf("error updating RAM resource share (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsRamResourceShareRead(d, meta)
+}
+
+func resourceAwsRamResourceShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	deleteResourceShareInput := &ram.DeleteResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		ResourceShareArn: aws.String(d.Id()),
+	}
+
+	log.Println("[DEBUG] Delete RAM resource share request:", deleteResourceShareInput)
+	_, err := conn.DeleteResourceShare(deleteResourceShareInput)
+	if err != nil {
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting RAM resource share (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusDeleting},
+		Target:  []string{ram.ResourceShareStatusDeleted},
+		Refresh: resourceAwsRamResourceShareStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) to become deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareStateRefreshFunc(conn *ram.RAM, resourceShareArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		request := &ram.GetResourceSharesInput{
+			ResourceShareArns: []*string{aws.String(resourceShareArn)},
+			ResourceOwner:     aws.String(ram.ResourceOwnerSelf),
+		}
+
+		output, err := conn.GetResourceShares(request)
+		if err != nil {
+			if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+				return 42, ram.ResourceShareStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if len(output.ResourceShares) == 0 {
+			return nil, "", nil
+		}
+
+		resourceShare := output.ResourceShares[0]
+
+		return resourceShare, aws.StringValue(resourceShare.Status), nil
+	}
+}
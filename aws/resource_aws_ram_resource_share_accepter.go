@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRamResourceShareAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRamResourceShareAccepterCreate,
+		Read:   resourceAwsRamResourceShareAccepterRead,
+		Delete: resourceAwsRamResourceShareAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"invitation_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"receiver_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sender_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+	shareARN := d.Get("share_arn").(string)
+
+	invitation, err := findRamResourceShareInvitation(conn, shareARN, ram.ResourceShareInvitationStatusPending)
+	if err != nil {
+		return fmt.Errorf("error reading RAM resource share (%s) invitation: %s", shareARN, err)
+	}
+
+	if invitation == nil {
+		return fmt.Errorf("no pending RAM resource share (%s) invitation found", shareARN)
+	}
+
+	input := &ram.AcceptResourceShareInvitationInput{
+		ClientToken:                aws.String(resource.UniqueId()),
+		ResourceShareInvitationArn: invitation.ResourceShareInvitationArn,
+	}
+
+	log.Printf("[DEBUG] Accepting RAM resource share invitation: %s", input)
+	output, err := conn.AcceptResourceShareInvitation(input)
+	if err != nil {
+		return fmt.Errorf("error accepting RAM resource share (%s) invitation: %s", shareARN, err)
+	}
+
+	d.SetId(shareARN)
+
+	invitationARN := aws.StringValue(output.ResourceShareInvitation.ResourceShareInvitationArn)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareInvitationStatusPending},
+		Target:  []string{ram.ResourceShareInvitationStatusAccepted},
+		Refresh: resourceAwsRamResourceShareInvitationStateRefreshFunc(conn, invitationARN),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) invitation (%s) to be accepted: %s", shareARN, invitationARN, err)
+	}
+
+	return resourceAwsRamResourceShareAccepterRead(d, meta)
+}
+
+func resourceAwsRamResourceShareAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	invitation, err := findRamResourceShareInvitation(conn, d.Id(), ram.ResourceShareInvitationStatusAccepted)
+	if err != nil {
+		return fmt.Errorf("error reading RAM resource share (%s) invitation: %s", d.Id(), err)
+	}
+
+	if invitation != nil {
+		d.Set("invitation_arn", invitation.ResourceShareInvitationArn)
+		d.Set("receiver_account_id", invitation.ReceiverAccountId)
+		d.Set("sender_account_id", invitation.SenderAccountId)
+	}
+
+	shares, err := conn.GetResourceShares(&ram.GetResourceSharesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: aws.StringSlice([]string{d.Id()}),
+	})
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		log.Printf("[WARN] RAM resource share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading RAM resource share (%s): %s", d.Id(), err)
+	}
+
+	if len(shares.ResourceShares) == 0 || aws.StringValue(shares.ResourceShares[0].Status) == ram.ResourceShareStatusDeleted {
+		log.Printf("[WARN] RAM resource share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	resourceShare := shares.ResourceShares[0]
+
+	d.Set("share_arn", resourceShare.ResourceShareArn)
+	d.Set("share_id", resourceAwsRamResourceShareGetIDFromARN(d.Id()))
+	d.Set("share_name", resourceShare.Name)
+	d.Set("status", resourceShare.Status)
+
+	var resourceARNs []*string
+	input := &ram.ListResourcesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: aws.StringSlice([]string{d.Id()}),
+	}
+
+	for {
+		output, err := conn.ListResources(input)
+		if err != nil {
+			return fmt.Errorf("error listing RAM resource share (%s) resources: %s", d.Id(), err)
+		}
+
+		for _, r := range output.Resources {
+			resourceARNs = append(resourceARNs, r.Arn)
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := d.Set("resources", flattenStringList(resourceARNs)); err != nil {
+		return fmt.Errorf("error setting resources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRamResourceShareAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ramconn
+
+	receiverAccountID := d.Get("receiver_account_id").(string)
+	if receiverAccountID == "" {
+		return fmt.Errorf("error leaving RAM resource share (%s): receiver account ID unknown", d.Id())
+	}
+
+	input := &ram.DisassociateResourceShareInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		Principals:       aws.StringSlice([]string{receiverAccountID}),
+		ResourceShareArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Leaving RAM resource share: %s", input)
+	_, err := conn.DisassociateResourceShare(input)
+	if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error leaving RAM resource share (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusActive},
+		Target:  []string{ram.ResourceShareStatusDeleted},
+		Refresh: resourceAwsRamResourceShareAccepterStateRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for RAM resource share (%s) to be left: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findRamResourceShareInvitation returns the invitation to the resource share
+// with the given status, or nil if there is none.
+func findRamResourceShareInvitation(conn *ram.RAM, resourceShareARN, status string) (*ram.ResourceShareInvitation, error) {
+	input := &ram.GetResourceShareInvitationsInput{
+		ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+	}
+
+	for {
+		output, err := conn.GetResourceShareInvitations(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, invitation := range output.ResourceShareInvitations {
+			if aws.StringValue(invitation.Status) == status {
+				return invitation, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func resourceAwsRamResourceShareInvitationStateRefreshFunc(conn *ram.RAM, invitationARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetResourceShareInvitations(&ram.GetResourceShareInvitationsInput{
+			ResourceShareInvitationArns: aws.StringSlice([]string{invitationARN}),
+		})
+		if isAWSErr(err, ram.ErrCodeResourceShareInvitationArnNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output.ResourceShareInvitations) == 0 {
+			return nil, "", nil
+		}
+
+		invitation := output.ResourceShareInvitations[0]
+
+		return invitation, aws.StringValue(invitation.Status), nil
+	}
+}
+
+func resourceAwsRamResourceShareAccepterStateRefreshFunc(conn *ram.RAM, resourceShareARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetResourceShares(&ram.GetResourceSharesInput{
+			ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+			ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+		})
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			return 42, ram.ResourceShareStatusDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The share is no longer visible once it has been left.
+		if len(output.ResourceShares) == 0 {
+			return 42, ram.ResourceShareStatusDeleted, nil
+		}
+
+		resourceShare := output.ResourceShares[0]
+
+		return resourceShare, aws.StringValue(resourceShare.Status), nil
+	}
+}
+
+func resourceAwsRamResourceShareGetIDFromARN(arn string) string {
+	return strings.Replace(arn[strings.LastIndex(arn, ":")+1:], "resource-share/", "rs-", -1)
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsRamResourceShareAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_ram_resource_share_accepter.test"
+	principalAssociationResourceName := "aws_ram_principal_association.test"
+	shareName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSRam(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAwsRamResourceShareAccepterDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareAccepterConfig_basic(shareName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "share_arn", principalAssociationResourceName, "resource_share_arn"),
+					resource.TestMatchResourceAttr(resourceName, "invitation_arn", regexp.MustCompile(`^arn:aws:ram:[a-z0-9-]+:[0-9]{12}:resource-share-invitation/.+$`)),
+					resource.TestMatchResourceAttr(resourceName, "share_id", regexp.MustCompile(`^rs-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "status", ram.ResourceShareStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "share_name", shareName),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "0"),
+				),
+			},
+			{
+				Config:            testAccAwsRamResourceShareAccepterConfig_basic(shareName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRamResourceShareAccepterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_share_accepter" {
+			continue
+		}
+
+		output, err := conn.GetResourceShares(&ram.GetResourceSharesInput{
+			ResourceOwner:     aws.String(ram.ResourceOwnerOtherAccounts),
+			ResourceShareArns: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(output.ResourceShares) > 0 && aws.StringValue(output.ResourceShares[0].Status) != ram.ResourceShareStatusDeleted {
+			return fmt.Errorf("RAM resource share (%s) still shared with this account", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsRamResourceShareAccepterConfig_basic(shareName string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  provider = "aws.alternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "aws.alternate"
+
+  principal          = "${data.aws_caller_identity.receiver.account_id}"
+  resource_share_arn = "${aws_ram_resource_share.test.arn}"
+}
+
+data "aws_caller_identity" "receiver" {}
+
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = "${aws_ram_principal_association.test.resource_share_arn}"
+}
+`, shareName)
+}
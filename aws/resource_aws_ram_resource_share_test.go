@@ -0,0 +1,280 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ram_resource_share", &resource.Sweeper{
+		Name: "aws_ram_resource_share",
+		F:    testSweepRamResourceShares,
+	})
+}
+
+func testSweepRamResourceShares(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ramconn
+
+	input := &ram.GetResourceSharesInput{
+		ResourceOwner: aws.String(ram.ResourceOwnerSelf),
+	}
+	for {
+		out, err := conn.GetResourceShares(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping RAM resource shares sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving RAM resource shares: %s", err)
+		}
+
+		for _, share := range out.ResourceShares {
+			arn := aws.StringValue(share.ResourceShareArn)
+
+			if !strings.HasPrefix(aws.StringValue(share.Name), "tf-acc-test-") {
+				log.Printf("[INFO] Skipping RAM resource share: %s", arn)
+				continue
+			}
+
+			if aws.StringValue(share.Status) != ram.ResourceShareStatusActive {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting RAM resource share: %s", arn)
+			_, err := conn.DeleteResourceShare(&ram.DeleteResourceShareInput{
+				ClientToken:      aws.String(resource.UniqueId()),
+				ResourceShareArn: aws.String(arn),
+			})
+			if isAWSErr(err, ram.ErrCodeUnknownResourceException, "") {
+				continue
+			}
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete RAM resource share %s: %s", arn, err)
+				continue
+			}
+
+			stateConf := &resource.StateChangeConf{
+				Pending: []string{ram.ResourceShareStatusDeleting},
+				Target:  []string{ram.ResourceShareStatusDeleted},
+				Refresh: resourceAwsRamResourceShareStateRefreshFunc(conn, arn),
+				Timeout: 5 * time.Minute,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				log.Printf("[ERROR] Failed to wait for RAM resource share %s deletion: %s", arn, err)
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAwsRamResourceShare_basic(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRam(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAwsRamResourceShareConfig_basic(rName+"-updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", "true"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsRamResourceShare_tags(t *testing.T) {
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRam(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRamResourceShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsRamResourceShareConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsRamResourceShareConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsRamResourceShareConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRamResourceShareExists(resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSRam(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).ramconn
+
+	input := &ram.GetResourceSharesInput{
+		ResourceOwner: aws.String(ram.ResourceOwnerSelf),
+	}
+
+	_, err := conn.GetResourceShares(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsRamResourceShareDestroy(s *terraform.State) error {
+	return testAccCheckAwsRamResourceShareDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckAwsRamResourceShareDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).ramconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ram_resource_share" {
+			continue
+		}
+
+		_, status, err := resourceAwsRamResourceShareStateRefreshFunc(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+
+		if status != "" && status != ram.ResourceShareStatusDeleted {
+			return fmt.Errorf("RAM resource share (%s) still exists with status %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsRamResourceShareExists(n string, share *ram.ResourceShare) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RAM resource share ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		resp, err := conn.GetResourceShares(&ram.GetResourceSharesInput{
+			ResourceOwner:     aws.String(ram.ResourceOwnerSelf),
+			ResourceShareArns: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.ResourceShares) == 0 {
+			return fmt.Errorf("RAM resource share (%s) not found", rs.Primary.ID)
+		}
+
+		if status := aws.StringValue(resp.ResourceShares[0].Status); status != ram.ResourceShareStatusActive {
+			return fmt.Errorf("RAM resource share (%s) has status %s", rs.Primary.ID, status)
+		}
+
+		*share = *resp.ResourceShares[0]
+
+		return nil
+	}
+}
+
+func testAccAwsRamResourceShareConfig_basic(rName string, allowExternalPrincipals bool) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "example" {
+  allow_external_principals = %t
+  name                      = %q
+}
+`, allowExternalPrincipals, rName)
+}
+
+func testAccAwsRamResourceShareConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "example" {
+  name = %q
+
+  tags = {
+    %q = %q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsRamResourceShareConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "example" {
+  name = %q
+
+  tags = {
+    %q = %q
+    %q = %q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -88,7 +88,7 @@ func resourceAwsRedshiftEventSubscriptionCreate(d *schema.ResourceData, meta int
 		SourceType:       aws.String(d.Get("source_type").(string)),
 		Severity:         aws.String(d.Get("severity").(string)),
 		EventCategories:  expandStringSet(d.Get("event_categories").(*schema.Set)),
-		Tags:             tagsFromMapRedshift(d.Get("tags").(map[string]interface{})),
+		Tags:             tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Println("[DEBUG] Create Redshift Event Subscription:", request)
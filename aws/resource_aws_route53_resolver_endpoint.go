@@ -0,0 +1,384 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	route53ResolverEndpointStatusDeleted = "DELETED"
+)
+
+func resourceAwsRoute53ResolverEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53ResolverEndpointCreate,
+		Read:   resourceAwsRoute53ResolverEndpointRead,
+		Update: resourceAwsRoute53ResolverEndpointUpdate,
+		Delete: resourceAwsRoute53ResolverEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					route53resolver.ResolverEndpointDirectionInbound,
+					route53resolver.ResolverEndpointDirectionOutbound,
+				}, false),
+			},
+			"host_vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+						"ip_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: route53ResolverEndpointHashIpAddress,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 64,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRoute53ResolverEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	input := &route53resolver.CreateResolverEndpointInput{
+		CreatorRequestId: aws.String(resource.PrefixedUniqueId("tf-r53-resolver-endpoint-")),
+		Direction:        aws.String(d.Get("direction").(string)),
+		IpAddresses:      expandRoute53ResolverEndpointIpAddresses(d.Get("ip_address").(*schema.Set)),
+		SecurityGroupIds: expandStringSet(d.Get("security_group_ids").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Tags = tagsFromMapRoute53Resolver(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Resolver endpoint: %s", input)
+	output, err := conn.CreateResolverEndpoint(input)
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Resolver endpoint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ResolverEndpoint.Id))
+
+	err = waitForRoute53ResolverEndpointStatus(conn, d.Id(), d.Timeout(schema.TimeoutCreate),
+		[]string{route53resolver.ResolverEndpointStatusCreating},
+		[]string{route53resolver.ResolverEndpointStatusOperational})
+	if err != nil {
+		return fmt.Errorf("error waiting for Route 53 Resolver endpoint (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53ResolverEndpointRead(d, meta)
+}
+
+func resourceAwsRoute53ResolverEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	epRaw, state, err := route53ResolverEndpointRefresh(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Resolver endpoint (%s): %s", d.Id(), err)
+	}
+
+	if state == route53ResolverEndpointStatusDeleted {
+		log.Printf("[WARN] Route 53 Resolver endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ep := epRaw.(*route53resolver.ResolverEndpoint)
+	d.Set("arn", ep.Arn)
+	d.Set("direction", ep.Direction)
+	d.Set("host_vpc_id", ep.HostVPCId)
+	d.Set("name", ep.Name)
+	if err := d.Set("security_group_ids", schema.NewSet(schema.HashString, flattenStringList(ep.SecurityGroupIds))); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	var ipAddresses []*route53resolver.IpAddressResponse
+	err = conn.ListResolverEndpointIpAddressesPages(&route53resolver.ListResolverEndpointIpAddressesInput{
+		ResolverEndpointId: aws.String(d.Id()),
+	}, func(page *route53resolver.ListResolverEndpointIpAddressesOutput, lastPage bool) bool {
+		ipAddresses = append(ipAddresses, page.IpAddresses...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Resolver endpoint (%s) IP addresses: %s", d.Id(), err)
+	}
+
+	if err := d.Set("ip_address", schema.NewSet(route53ResolverEndpointHashIpAddress, flattenRoute53ResolverEndpointIpAddresses(ipAddresses))); err != nil {
+		return fmt.Errorf("error setting ip_address: %s", err)
+	}
+
+	if err := getTagsRoute53Resolver(conn, d); err != nil {
+		return fmt.Errorf("error reading Route 53 Resolver endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53ResolverEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		_, err := conn.UpdateResolverEndpoint(&route53resolver.UpdateResolverEndpointInput{
+			ResolverEndpointId: aws.String(d.Id()),
+			Name:               aws.String(d.Get("name").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver endpoint (%s): %s", d.Id(), err)
+		}
+
+		err = waitForRoute53ResolverEndpointStatus(conn, d.Id(), d.Timeout(schema.TimeoutUpdate),
+			[]string{route53resolver.ResolverEndpointStatusUpdating},
+			[]string{route53resolver.ResolverEndpointStatusOperational})
+		if err != nil {
+			return fmt.Errorf("error waiting for Route 53 Resolver endpoint (%s) update: %s", d.Id(), err)
+		}
+
+		d.SetPartial("name")
+	}
+
+	if d.HasChange("ip_address") {
+		o, n := d.GetChange("ip_address")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// Add new IP addresses before removing old ones as an endpoint
+		// must always have at least two.
+		for _, v := range ns.Difference(os).List() {
+			_, err := conn.AssociateResolverEndpointIpAddress(&route53resolver.AssociateResolverEndpointIpAddressInput{
+				ResolverEndpointId: aws.String(d.Id()),
+				IpAddress:          expandRoute53ResolverEndpointIpAddressUpdate(v),
+			})
+			if err != nil {
+				return fmt.Errorf("error associating Route 53 Resolver endpoint (%s) IP address: %s", d.Id(), err)
+			}
+
+			err = waitForRoute53ResolverEndpointStatus(conn, d.Id(), d.Timeout(schema.TimeoutUpdate),
+				[]string{route53resolver.ResolverEndpointStatusUpdating},
+				[]string{route53resolver.ResolverEndpointStatusOperational})
+			if err != nil {
+				return fmt.Errorf("error waiting for Route 53 Resolver endpoint (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range os.Difference(ns).List() {
+			_, err := conn.DisassociateResolverEndpointIpAddress(&route53resolver.DisassociateResolverEndpointIpAddressInput{
+				ResolverEndpointId: aws.String(d.Id()),
+				IpAddress:          expandRoute53ResolverEndpointIpAddressUpdate(v),
+			})
+			if err != nil {
+				return fmt.Errorf("error disassociating Route 53 Resolver endpoint (%s) IP address: %s", d.Id(), err)
+			}
+
+			err = waitForRoute53ResolverEndpointStatus(conn, d.Id(), d.Timeout(schema.TimeoutUpdate),
+				[]string{route53resolver.ResolverEndpointStatusUpdating},
+				[]string{route53resolver.ResolverEndpointStatusOperational})
+			if err != nil {
+				return fmt.Errorf("error waiting for Route 53 Resolver endpoint (%s) update: %s", d.Id(), err)
+			}
+		}
+
+		d.SetPartial("ip_address")
+	}
+
+	if d.HasChange("tags_all") {
+		if err := setTagsRoute53Resolver(conn, d); err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver endpoint (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsRoute53ResolverEndpointRead(d, meta)
+}
+
+func resourceAwsRoute53ResolverEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Resolver endpoint: %s", d.Id())
+	_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
+		ResolverEndpointId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Resolver endpoint (%s): %s", d.Id(), err)
+	}
+
+	err = waitForRoute53ResolverEndpointStatus(conn, d.Id(), d.Timeout(schema.TimeoutDelete),
+		[]string{route53resolver.ResolverEndpointStatusDeleting},
+		[]string{route53ResolverEndpointStatusDeleted})
+	if err != nil {
+		return fmt.Errorf("error waiting for Route 53 Resolver endpoint (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func route53ResolverEndpointRefresh(conn *route53resolver.Route53Resolver, epId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetResolverEndpoint(&route53resolver.GetResolverEndpointInput{
+			ResolverEndpointId: aws.String(epId),
+		})
+		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+			return &route53resolver.ResolverEndpoint{}, route53ResolverEndpointStatusDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if statusMessage := aws.StringValue(resp.ResolverEndpoint.StatusMessage); statusMessage != "" {
+			log.Printf("[INFO] Route 53 Resolver endpoint (%s) status message: %s", epId, statusMessage)
+		}
+
+		return resp.ResolverEndpoint, aws.StringValue(resp.ResolverEndpoint.Status), nil
+	}
+}
+
+func waitForRoute53ResolverEndpointStatus(conn *route53resolver.Route53Resolver, epId string, timeout time.Duration, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    route53ResolverEndpointRefresh(conn, epId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func route53ResolverEndpointHashIpAddress(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-%s-", m["subnet_id"].(string), m["ip"].(string)))
+	return hashcode.String(buf.String())
+}
+
+func expandRoute53ResolverEndpointIpAddresses(vIpAddresses *schema.Set) []*route53resolver.IpAddressRequest {
+	ipAddressRequests := []*route53resolver.IpAddressRequest{}
+
+	for _, vIpAddress := range vIpAddresses.List() {
+		ipAddressRequest := &route53resolver.IpAddressRequest{}
+
+		mIpAddress := vIpAddress.(map[string]interface{})
+
+		if vSubnetId, ok := mIpAddress["subnet_id"].(string); ok && vSubnetId != "" {
+			ipAddressRequest.SubnetId = aws.String(vSubnetId)
+		}
+		if vIp, ok := mIpAddress["ip"].(string); ok && vIp != "" {
+			ipAddressRequest.Ip = aws.String(vIp)
+		}
+
+		ipAddressRequests = append(ipAddressRequests, ipAddressRequest)
+	}
+
+	return ipAddressRequests
+}
+
+func expandRoute53ResolverEndpointIpAddressUpdate(vIpAddress interface{}) *route53resolver.IpAddressUpdate {
+	ipAddressUpdate := &route53resolver.IpAddressUpdate{}
+
+	mIpAddress := vIpAddress.(map[string]interface{})
+
+	if vSubnetId, ok := mIpAddress["subnet_id"].(string); ok && vSubnetId != "" {
+		ipAddressUpdate.SubnetId = aws.String(vSubnetId)
+	}
+	if vIp, ok := mIpAddress["ip"].(string); ok && vIp != "" {
+		ipAddressUpdate.Ip = aws.String(vIp)
+	}
+	if vIpId, ok := mIpAddress["ip_id"].(string); ok && vIpId != "" {
+		ipAddressUpdate.IpId = aws.String(vIpId)
+	}
+
+	return ipAddressUpdate
+}
+
+func flattenRoute53ResolverEndpointIpAddresses(ipAddresses []*route53resolver.IpAddressResponse) []interface{} {
+	if ipAddresses == nil {
+		return []interface{}{}
+	}
+
+	vIpAddresses := []interface{}{}
+
+	for _, ipAddress := range ipAddresses {
+		mIpAddress := map[string]interface{}{
+			"subnet_id": aws.StringValue(ipAddress.SubnetId),
+			"ip":        aws.StringValue(ipAddress.Ip),
+			"ip_id":     aws.StringValue(ipAddress.IpId),
+		}
+
+		vIpAddresses = append(vIpAddresses, mIpAddress)
+	}
+
+	return vIpAddresses
+}
@@ -0,0 +1,340 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+		Name: "aws_route53_resolver_endpoint",
+		F:    testSweepRoute53ResolverEndpoints,
+		Dependencies: []string{
+			"aws_route53_resolver_rule",
+		},
+	})
+}
+
+func testSweepRoute53ResolverEndpoints(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).route53resolverconn
+
+	input := &route53resolver.ListResolverEndpointsInput{}
+	for {
+		out, err := conn.ListResolverEndpoints(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping Route 53 Resolver endpoints sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Route 53 Resolver endpoints: %s", err)
+		}
+
+		for _, ep := range out.ResolverEndpoints {
+			id := aws.StringValue(ep.Id)
+
+			if !strings.HasPrefix(aws.StringValue(ep.Name), "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Route 53 Resolver endpoint: %s", id)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting Route 53 Resolver endpoint: %s", id)
+			_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
+				ResolverEndpointId: aws.String(id),
+			})
+			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Route 53 Resolver endpoint %s: %s", id, err)
+				continue
+			}
+
+			err = waitForRoute53ResolverEndpointStatus(conn, id, 10*time.Minute,
+				[]string{route53resolver.ResolverEndpointStatusDeleting},
+				[]string{route53ResolverEndpointStatusDeleted})
+			if err != nil {
+				log.Printf("[ERROR] Failed to wait for Route 53 Resolver endpoint %s deletion: %s", id, err)
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAwsRoute53ResolverEndpoint_basicInbound(t *testing.T) {
+	var ep route53resolver.ResolverEndpoint
+	resourceName := "aws_route53_resolver_endpoint.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverEndpointConfig_initial(rName, "INBOUND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverEndpointExists(resourceName, &ep),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "direction", "INBOUND"),
+					resource.TestCheckResourceAttrPair(resourceName, "host_vpc_id", "aws_vpc.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53ResolverEndpoint_updateOutbound(t *testing.T) {
+	var ep route53resolver.ResolverEndpoint
+	resourceName := "aws_route53_resolver_endpoint.test"
+	initialName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	updatedName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverEndpointConfig_initial(initialName, "OUTBOUND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverEndpointExists(resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "direction", "OUTBOUND"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "name", initialName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccRoute53ResolverEndpointConfig_updated(updatedName, "OUTBOUND"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverEndpointExists(resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "direction", "OUTBOUND"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "changed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53ResolverEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_resolver_endpoint" {
+			continue
+		}
+
+		// Try to find the resource
+		_, err := conn.GetResolverEndpoint(&route53resolver.GetResolverEndpointInput{
+			ResolverEndpointId: aws.String(rs.Primary.ID),
+		})
+		// Verify the error is what we want
+		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Route 53 Resolver endpoint still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRoute53ResolverEndpointExists(n string, ep *route53resolver.ResolverEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Resolver endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		resp, err := conn.GetResolverEndpoint(&route53resolver.GetResolverEndpointInput{
+			ResolverEndpointId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*ep = *resp.ResolverEndpoint
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSRoute53Resolver(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+
+	input := &route53resolver.ListResolverEndpointsInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.ListResolverEndpoints(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccRoute53ResolverEndpointConfig_base() string {
+	return `
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = "terraform-testacc-r53-resolver-vpc"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "sn1" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "${cidrsubnet(aws_vpc.foo.cidr_block, 2, 0)}"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+
+  tags = {
+    Name = "tf-acc-r53-resolver-sn1"
+  }
+}
+
+resource "aws_subnet" "sn2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "${cidrsubnet(aws_vpc.foo.cidr_block, 2, 1)}"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+
+  tags = {
+    Name = "tf-acc-r53-resolver-sn2"
+  }
+}
+
+resource "aws_subnet" "sn3" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "${cidrsubnet(aws_vpc.foo.cidr_block, 2, 2)}"
+  availability_zone = "${data.aws_availability_zones.available.names[2]}"
+
+  tags = {
+    Name = "tf-acc-r53-resolver-sn3"
+  }
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+  name   = "tf-acc-r53-resolver-sg1"
+
+  tags = {
+    Name = "tf-acc-r53-resolver-sg1"
+  }
+}
+
+resource "aws_security_group" "sg2" {
+  vpc_id = "${aws_vpc.foo.id}"
+  name   = "tf-acc-r53-resolver-sg2"
+
+  tags = {
+    Name = "tf-acc-r53-resolver-sg2"
+  }
+}
+`
+}
+
+func testAccRoute53ResolverEndpointConfig_initial(name, direction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_route53_resolver_endpoint" "test" {
+  direction = "%s"
+  name      = "%s"
+
+  security_group_ids = [
+    "${aws_security_group.sg1.id}",
+    "${aws_security_group.sg2.id}",
+  ]
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn1.id}"
+  }
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn2.id}"
+    ip        = "${cidrhost(aws_subnet.sn2.cidr_block, 8)}"
+  }
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn3.id}"
+  }
+}
+`, testAccRoute53ResolverEndpointConfig_base(), direction, name)
+}
+
+func testAccRoute53ResolverEndpointConfig_updated(name, direction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_route53_resolver_endpoint" "test" {
+  direction = "%s"
+  name      = "%s"
+
+  security_group_ids = [
+    "${aws_security_group.sg1.id}",
+    "${aws_security_group.sg2.id}",
+  ]
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn1.id}"
+  }
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn3.id}"
+  }
+
+  tags = {
+    Environment = "production"
+    Usage       = "changed"
+  }
+}
+`, testAccRoute53ResolverEndpointConfig_base(), direction, name)
+}
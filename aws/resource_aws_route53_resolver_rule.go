@@ -0,0 +1,336 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	route53ResolverRuleStatusDeleted = "DELETED"
+)
+
+func resourceAwsRoute53ResolverRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53ResolverRuleCreate,
+		Read:   resourceAwsRoute53ResolverRuleRead,
+		Update: resourceAwsRoute53ResolverRuleUpdate,
+		Delete: resourceAwsRoute53ResolverRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringLenBetween(1, 256),
+				DiffSuppressFunc: suppressRoute53ZoneNameWithTrailingDot,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolver_endpoint_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					route53resolver.RuleTypeOptionForward,
+					route53resolver.RuleTypeOptionSystem,
+					route53resolver.RuleTypeOptionRecursive,
+				}, false),
+			},
+			"share_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_ip": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      53,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+					},
+				},
+				Set: route53ResolverRuleHashTargetIp,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRoute53ResolverRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	input := &route53resolver.CreateResolverRuleInput{
+		CreatorRequestId: aws.String(resource.PrefixedUniqueId("tf-r53-resolver-rule-")),
+		DomainName:       aws.String(d.Get("domain_name").(string)),
+		RuleType:         aws.String(d.Get("rule_type").(string)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("resolver_endpoint_id"); ok {
+		input.ResolverEndpointId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("target_ip"); ok {
+		input.TargetIps = expandRoute53ResolverRuleTargetIps(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Tags = tagsFromMapRoute53Resolver(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Route 53 Resolver rule: %s", input)
+	output, err := conn.CreateResolverRule(input)
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 Resolver rule: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ResolverRule.Id))
+
+	err = waitForRoute53ResolverRuleStatus(conn, d.Id(), d.Timeout(schema.TimeoutCreate),
+		[]string{}, // Should go straight to COMPLETE
+		[]string{route53resolver.ResolverRuleStatusComplete})
+	if err != nil {
+		return fmt.Errorf("error waiting for Route 53 Resolver rule (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53ResolverRuleRead(d, meta)
+}
+
+func resourceAwsRoute53ResolverRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	ruleRaw, state, err := route53ResolverRuleRefresh(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Resolver rule (%s): %s", d.Id(), err)
+	}
+
+	if state == route53ResolverRuleStatusDeleted {
+		log.Printf("[WARN] Route 53 Resolver rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	rule := ruleRaw.(*route53resolver.ResolverRule)
+	d.Set("arn", rule.Arn)
+	// To be consistent with other AWS services that do not accept a trailing period,
+	// we remove the suffix from the Domain Name returned from the API
+	d.Set("domain_name", strings.TrimSuffix(aws.StringValue(rule.DomainName), "."))
+	d.Set("name", rule.Name)
+	d.Set("owner_id", rule.OwnerId)
+	d.Set("resolver_endpoint_id", rule.ResolverEndpointId)
+	d.Set("rule_type", rule.RuleType)
+	d.Set("share_status", rule.ShareStatus)
+	if err := d.Set("target_ip", schema.NewSet(route53ResolverRuleHashTargetIp, flattenRoute53ResolverRuleTargetIps(rule.TargetIps))); err != nil {
+		return fmt.Errorf("error setting target_ip: %s", err)
+	}
+
+	// Rules shared with this account cannot be tagged by it.
+	if aws.StringValue(rule.ShareStatus) != route53resolver.ShareStatusSharedWithMe {
+		if err := getTagsRoute53Resolver(conn, d); err != nil {
+			return fmt.Errorf("error reading Route 53 Resolver rule (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53ResolverRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	d.Partial(true)
+
+	if d.HasChange("name") || d.HasChange("resolver_endpoint_id") || d.HasChange("target_ip") {
+		input := &route53resolver.UpdateResolverRuleInput{
+			ResolverRuleId: aws.String(d.Id()),
+			Config:         &route53resolver.ResolverRuleConfig{},
+		}
+
+		if v, ok := d.GetOk("name"); ok {
+			input.Config.Name = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("resolver_endpoint_id"); ok {
+			input.Config.ResolverEndpointId = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("target_ip"); ok {
+			input.Config.TargetIps = expandRoute53ResolverRuleTargetIps(v.(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] Updating Route 53 Resolver rule: %s", input)
+		_, err := conn.UpdateResolverRule(input)
+		if err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver rule (%s): %s", d.Id(), err)
+		}
+
+		err = waitForRoute53ResolverRuleStatus(conn, d.Id(), d.Timeout(schema.TimeoutUpdate),
+			[]string{route53resolver.ResolverRuleStatusUpdating},
+			[]string{route53resolver.ResolverRuleStatusComplete})
+		if err != nil {
+			return fmt.Errorf("error waiting for Route 53 Resolver rule (%s) update: %s", d.Id(), err)
+		}
+
+		d.SetPartial("name")
+		d.SetPartial("resolver_endpoint_id")
+		d.SetPartial("target_ip")
+	}
+
+	if d.HasChange("tags_all") {
+		if err := setTagsRoute53Resolver(conn, d); err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver rule (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsRoute53ResolverRuleRead(d, meta)
+}
+
+func resourceAwsRoute53ResolverRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53resolverconn
+
+	log.Printf("[DEBUG] Deleting Route 53 Resolver rule: %s", d.Id())
+	_, err := conn.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{
+		ResolverRuleId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Resolver rule (%s): %s", d.Id(), err)
+	}
+
+	err = waitForRoute53ResolverRuleStatus(conn, d.Id(), d.Timeout(schema.TimeoutDelete),
+		[]string{route53resolver.ResolverRuleStatusDeleting},
+		[]string{route53ResolverRuleStatusDeleted})
+	if err != nil {
+		return fmt.Errorf("error waiting for Route 53 Resolver rule (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func route53ResolverRuleRefresh(conn *route53resolver.Route53Resolver, ruleId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetResolverRule(&route53resolver.GetResolverRuleInput{
+			ResolverRuleId: aws.String(ruleId),
+		})
+		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+			return &route53resolver.ResolverRule{}, route53ResolverRuleStatusDeleted, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if statusMessage := aws.StringValue(resp.ResolverRule.StatusMessage); statusMessage != "" {
+			log.Printf("[INFO] Route 53 Resolver rule (%s) status message: %s", ruleId, statusMessage)
+		}
+
+		return resp.ResolverRule, aws.StringValue(resp.ResolverRule.Status), nil
+	}
+}
+
+func waitForRoute53ResolverRuleStatus(conn *route53resolver.Route53Resolver, ruleId string, timeout time.Duration, pending, target []string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    route53ResolverRuleRefresh(conn, ruleId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func route53ResolverRuleHashTargetIp(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-%d-", m["ip"].(string), m["port"].(int)))
+	return hashcode.String(buf.String())
+}
+
+func expandRoute53ResolverRuleTargetIps(vTargetIps *schema.Set) []*route53resolver.TargetAddress {
+	targetAddresses := []*route53resolver.TargetAddress{}
+
+	for _, vTargetIp := range vTargetIps.List() {
+		targetAddress := &route53resolver.TargetAddress{}
+
+		mTargetIp := vTargetIp.(map[string]interface{})
+
+		if vIp, ok := mTargetIp["ip"].(string); ok && vIp != "" {
+			targetAddress.Ip = aws.String(vIp)
+		}
+		if vPort, ok := mTargetIp["port"].(int); ok {
+			targetAddress.Port = aws.Int64(int64(vPort))
+		}
+
+		targetAddresses = append(targetAddresses, targetAddress)
+	}
+
+	return targetAddresses
+}
+
+func flattenRoute53ResolverRuleTargetIps(targetAddresses []*route53resolver.TargetAddress) []interface{} {
+	if targetAddresses == nil {
+		return []interface{}{}
+	}
+
+	vTargetIps := []interface{}{}
+
+	for _, targetAddress := range targetAddresses {
+		mTargetIp := map[string]interface{}{
+			"ip":   aws.StringValue(targetAddress.Ip),
+			"port": int(aws.Int64Value(targetAddress.Port)),
+		}
+
+		vTargetIps = append(vTargetIps, mTargetIp)
+	}
+
+	return vTargetIps
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+		Name: "aws_route53_resolver_rule_association",
+		F:    testSweepRoute53ResolverRuleAssociations,
+	})
+}
+
+func testSweepRoute53ResolverRuleAssociations(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).route53resolverconn
+
+	input := &route53resolver.ListResolverRuleAssociationsInput{}
+	for {
+		out, err := conn.ListResolverRuleAssociations(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping Route 53 Resolver rule associations sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Route 53 Resolver rule associations: %s", err)
+		}
+
+		for _, assoc := range out.ResolverRuleAssociations {
+			id := aws.StringValue(assoc.Id)
+
+			if !strings.HasPrefix(aws.StringValue(assoc.Name), "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Route 53 Resolver rule association: %s", id)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting Route 53 Resolver rule association: %s", id)
+			_, err := conn.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{
+				ResolverRuleId: assoc.ResolverRuleId,
+				VPCId:          assoc.VPCId,
+			})
+			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Route 53 Resolver rule association %s: %s", id, err)
+				continue
+			}
+
+			err = waitForRoute53ResolverRuleAssociationStatus(conn, id, 10*time.Minute,
+				[]string{route53resolver.ResolverRuleAssociationStatusDeleting},
+				[]string{route53ResolverRuleAssociationStatusDeleted})
+			if err != nil {
+				log.Printf("[ERROR] Failed to wait for Route 53 Resolver rule association %s deletion: %s", id, err)
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAwsRoute53ResolverRuleAssociation_basic(t *testing.T) {
+	var assn route53resolver.ResolverRuleAssociation
+	resourceName := "aws_route53_resolver_rule_association.foo"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverRuleAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverRuleAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverRuleAssociationExists(resourceName, &assn),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "resolver_rule_id", "aws_route53_resolver_rule.example", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.example", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53ResolverRuleAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_resolver_rule_association" {
+			continue
+		}
+
+		// Try to find the resource
+		_, err := conn.GetResolverRuleAssociation(&route53resolver.GetResolverRuleAssociationInput{
+			ResolverRuleAssociationId: aws.String(rs.Primary.ID),
+		})
+		// Verify the error is what we want
+		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Route 53 Resolver rule association still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRoute53ResolverRuleAssociationExists(n string, assn *route53resolver.ResolverRuleAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Resolver rule association ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		resp, err := conn.GetResolverRuleAssociation(&route53resolver.GetResolverRuleAssociationInput{
+			ResolverRuleAssociationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*assn = *resp.ResolverRuleAssociation
+
+		return nil
+	}
+}
+
+func testAccRoute53ResolverRuleAssociationConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "example" {
+  cidr_block           = "10.6.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+
+  tags = {
+    Name = "terraform-testacc-r53-resolver-rule-association"
+  }
+}
+
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  name        = "%s"
+  rule_type   = "SYSTEM"
+}
+
+resource "aws_route53_resolver_rule_association" "foo" {
+  name             = "%s"
+  resolver_rule_id = "${aws_route53_resolver_rule.example.id}"
+  vpc_id           = "${aws_vpc.example.id}"
+}
+`, name, name)
+}
@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+		Name: "aws_route53_resolver_rule",
+		F:    testSweepRoute53ResolverRules,
+		Dependencies: []string{
+			"aws_route53_resolver_rule_association",
+		},
+	})
+}
+
+func testSweepRoute53ResolverRules(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).route53resolverconn
+
+	input := &route53resolver.ListResolverRulesInput{}
+	for {
+		out, err := conn.ListResolverRules(input)
+		if err != nil {
+			if testSweepSkipSweepError(err) {
+				log.Printf("[WARN] Skipping Route 53 Resolver rules sweep for %s: %s", region, err)
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Route 53 Resolver rules: %s", err)
+		}
+
+		for _, rule := range out.ResolverRules {
+			id := aws.StringValue(rule.Id)
+
+			if !strings.HasPrefix(aws.StringValue(rule.Name), "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Route 53 Resolver rule: %s", id)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting Route 53 Resolver rule: %s", id)
+			_, err := conn.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{
+				ResolverRuleId: aws.String(id),
+			})
+			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Route 53 Resolver rule %s: %s", id, err)
+				continue
+			}
+
+			err = waitForRoute53ResolverRuleStatus(conn, id, 10*time.Minute,
+				[]string{route53resolver.ResolverRuleStatusDeleting},
+				[]string{route53ResolverRuleStatusDeleted})
+			if err != nil {
+				log.Printf("[ERROR] Failed to wait for Route 53 Resolver rule %s deletion: %s", id, err)
+			}
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAwsRoute53ResolverRule_basic(t *testing.T) {
+	var rule route53resolver.ResolverRule
+	resourceName := "aws_route53_resolver_rule.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverRuleConfig_basicNoTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SYSTEM"),
+					resource.TestCheckResourceAttr(resourceName, "share_status", "NOT_SHARED"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53ResolverRule_tags(t *testing.T) {
+	var rule route53resolver.ResolverRule
+	resourceName := "aws_route53_resolver_rule.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverRuleConfig_basicTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "original"),
+				),
+			},
+			{
+				Config: testAccRoute53ResolverRuleConfig_basicTagsChanged,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "changed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53ResolverRule_forward(t *testing.T) {
+	var rule1, rule2 route53resolver.ResolverRule
+	resourceName := "aws_route53_resolver_rule.example"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Resolver(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53ResolverRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53ResolverRuleConfig_forward(rName, "192.0.2.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverRuleExists(resourceName, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "FORWARD"),
+					resource.TestCheckResourceAttrPair(resourceName, "resolver_endpoint_id", "aws_route53_resolver_endpoint.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoute53ResolverRuleConfig_forward(rName, "192.0.2.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53ResolverRuleExists(resourceName, &rule2),
+					testAccCheckRoute53ResolverRulesSame(&rule2, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53ResolverRulesSame(before, after *route53resolver.ResolverRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.Arn) != aws.StringValue(after.Arn) {
+			return fmt.Errorf("Expected Route 53 Resolver rule ARNs to be the same. But they were: %v, %v", aws.StringValue(before.Arn), aws.StringValue(after.Arn))
+		}
+		return nil
+	}
+}
+
+func testAccCheckRoute53ResolverRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_resolver_rule" {
+			continue
+		}
+
+		// Try to find the resource
+		_, err := conn.GetResolverRule(&route53resolver.GetResolverRuleInput{
+			ResolverRuleId: aws.String(rs.Primary.ID),
+		})
+		// Verify the error is what we want
+		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("Route 53 Resolver rule still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRoute53ResolverRuleExists(n string, rule *route53resolver.ResolverRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Resolver rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		resp, err := conn.GetResolverRule(&route53resolver.GetResolverRuleInput{
+			ResolverRuleId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*rule = *resp.ResolverRule
+
+		return nil
+	}
+}
+
+const testAccRoute53ResolverRuleConfig_basicNoTags = `
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  rule_type   = "SYSTEM"
+}
+`
+
+const testAccRoute53ResolverRuleConfig_basicTags = `
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  rule_type   = "SYSTEM"
+
+  tags = {
+    Environment = "production"
+    Usage       = "original"
+  }
+}
+`
+
+const testAccRoute53ResolverRuleConfig_basicTagsChanged = `
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  rule_type   = "SYSTEM"
+
+  tags = {
+    Usage = "changed"
+  }
+}
+`
+
+func testAccRoute53ResolverRuleConfig_forward(name, targetIp string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_route53_resolver_endpoint" "foo" {
+  direction = "OUTBOUND"
+  name      = "%s"
+
+  security_group_ids = [
+    "${aws_security_group.sg1.id}",
+    "${aws_security_group.sg2.id}",
+  ]
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn1.id}"
+  }
+
+  ip_address {
+    subnet_id = "${aws_subnet.sn2.id}"
+  }
+}
+
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  name        = "%s"
+  rule_type   = "FORWARD"
+
+  resolver_endpoint_id = "${aws_route53_resolver_endpoint.foo.id}"
+
+  target_ip {
+    ip = "%s"
+  }
+}
+`, testAccRoute53ResolverEndpointConfig_base(), name, name, targetIp)
+}
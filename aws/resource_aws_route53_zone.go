@@ -312,12 +312,14 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("comment")
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsR53(conn, d, route53.TagResourceTypeHostedzone); err != nil {
 			return err
 		}
 
 		d.SetPartial("tags")
+
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("vpc") {
@@ -3,6 +3,7 @@ package aws
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
@@ -32,6 +33,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 			State: resourceAwsS3BucketImportState,
 		},
 
+		CustomizeDiff: resourceAwsS3BucketCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:          schema.TypeString,
@@ -530,6 +533,61 @@ func resourceAwsS3Bucket() *schema.Resource {
 				},
 			},
 
+			"object_lock_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_lock_enabled": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.ObjectLockEnabledEnabled,
+							}, false),
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_retention": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mode": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														s3.ObjectLockRetentionModeGovernance,
+														s3.ObjectLockRetentionModeCompliance,
+													}, false),
+												},
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"years": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -573,6 +631,16 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// S3 Object Lock can only be enabled when the bucket is created.
+	if v, ok := d.GetOk("object_lock_configuration"); ok {
+		if l := v.([]interface{}); len(l) > 0 && l[0] != nil {
+			m := l[0].(map[string]interface{})
+			if m["object_lock_enabled"].(string) == s3.ObjectLockEnabledEnabled {
+				req.ObjectLockEnabledForBucket = aws.Bool(true)
+			}
+		}
+	}
+
 	if err := validateS3BucketName(bucket, awsRegion); err != nil {
 		return fmt.Errorf("Error validating S3 bucket name: %s", err)
 	}
@@ -675,6 +743,12 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("object_lock_configuration") {
+		if err := resourceAwsS3BucketObjectLockConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsS3BucketRead(d, meta)
 }
 
@@ -1082,6 +1156,31 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting server_side_encryption_configuration: %s", err)
 	}
 
+	// Read the bucket object lock configuration
+
+	objectLockResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	if err != nil && !isAWSErr(err, "ObjectLockConfigurationNotFoundError", "") {
+		// Object Lock might not be readable by the caller or supported by
+		// S3 compatible endpoints, which only matters when it is configured
+		_, configured := d.GetOk("object_lock_configuration")
+		if configured || !(isAWSErr(err, "AccessDenied", "") || isAWSErr(err, "NotImplemented", "") || isAWSErr(err, "MethodNotAllowed", "")) {
+			return fmt.Errorf("error getting S3 Bucket Object Lock configuration: %s", err)
+		}
+		log.Printf("[WARN] Unable to read S3 Bucket (%s) Object Lock configuration: %s", d.Id(), err)
+	}
+
+	objectLockConfiguration := make([]interface{}, 0)
+	if objectLock, ok := objectLockResponse.(*s3.GetObjectLockConfigurationOutput); ok {
+		objectLockConfiguration = flattenS3ObjectLockConfiguration(objectLock.ObjectLockConfiguration)
+	}
+	if err := d.Set("object_lock_configuration", objectLockConfiguration); err != nil {
+		return fmt.Errorf("error setting object_lock_configuration: %s", err)
+	}
+
 	// Add the region as an attribute
 
 	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
@@ -1208,12 +1307,25 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 				},
 			}
 
-			_, err = s3conn.DeleteObjects(params)
+			// Objects locked in governance mode can only be removed when bypassing retention.
+			if v, ok := d.GetOk("object_lock_configuration"); ok && len(v.([]interface{})) > 0 {
+				params.BypassGovernanceRetention = aws.Bool(true)
+			}
+
+			deleteResp, err := s3conn.DeleteObjects(params)
 
 			if err != nil {
 				return fmt.Errorf("Error S3 Bucket force_destroy error deleting: %s", err)
 			}
 
+			// Objects that cannot be deleted, such as those locked in
+			// compliance mode or under legal hold, are reported per object.
+			if len(deleteResp.Errors) != 0 {
+				e := deleteResp.Errors[0]
+				return fmt.Errorf("Error S3 Bucket force_destroy error deleting %d objects, first error deleting %q (version %q): %s: %s",
+					len(deleteResp.Errors), aws.StringValue(e.Key), aws.StringValue(e.VersionId), aws.StringValue(e.Code), aws.StringValue(e.Message))
+			}
+
 			// this line recurses until all objects are deleted or an error is returned
 			return resourceAwsS3BucketDelete(d, meta)
 		}
@@ -1729,6 +1841,48 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 	return nil
 }
 
+// errS3BucketObjectLockRemoved is returned when the object_lock_configuration
+// of a bucket with Object Lock enabled is removed.
+var errS3BucketObjectLockRemoved = errors.New("object_lock_configuration cannot be removed: S3 Object Lock cannot be disabled once it is enabled on a bucket")
+
+// resourceAwsS3BucketCustomizeDiff prevents removing the Object Lock
+// configuration of an existing bucket, which would otherwise plan to replace
+// the bucket with all of its locked objects.
+func resourceAwsS3BucketCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("object_lock_configuration") {
+		return nil
+	}
+
+	o, n := diff.GetChange("object_lock_configuration")
+	if len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+		return errS3BucketObjectLockRemoved
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectLockConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	// S3 Object Lock configuration cannot be deleted, only updated.
+	conf := expandS3ObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{}))
+	if conf == nil {
+		return fmt.Errorf("error putting S3 Bucket Object Lock configuration: %s", errS3BucketObjectLockRemoved)
+	}
+
+	req := &s3.PutObjectLockConfigurationInput{
+		Bucket:                  aws.String(d.Get("bucket").(string)),
+		ObjectLockConfiguration: conf,
+	}
+
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutObjectLockConfiguration(req)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket Object Lock configuration: %s", err)
+	}
+
+	return nil
+}
+
 func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
@@ -2150,6 +2304,70 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 	return replication_configuration
 }
 
+func expandS3ObjectLockConfiguration(vConf []interface{}) *s3.ObjectLockConfiguration {
+	if len(vConf) == 0 || vConf[0] == nil {
+		return nil
+	}
+
+	mConf := vConf[0].(map[string]interface{})
+
+	conf := &s3.ObjectLockConfiguration{}
+
+	if vObjectLockEnabled, ok := mConf["object_lock_enabled"].(string); ok && vObjectLockEnabled != "" {
+		conf.ObjectLockEnabled = aws.String(vObjectLockEnabled)
+	}
+
+	if vRule, ok := mConf["rule"].([]interface{}); ok && len(vRule) > 0 && vRule[0] != nil {
+		mRule := vRule[0].(map[string]interface{})
+
+		if vDefaultRetention, ok := mRule["default_retention"].([]interface{}); ok && len(vDefaultRetention) > 0 && vDefaultRetention[0] != nil {
+			mDefaultRetention := vDefaultRetention[0].(map[string]interface{})
+
+			conf.Rule = &s3.ObjectLockRule{
+				DefaultRetention: &s3.DefaultRetention{},
+			}
+
+			if vMode, ok := mDefaultRetention["mode"].(string); ok && vMode != "" {
+				conf.Rule.DefaultRetention.Mode = aws.String(vMode)
+			}
+			if vDays, ok := mDefaultRetention["days"].(int); ok && vDays > 0 {
+				conf.Rule.DefaultRetention.Days = aws.Int64(int64(vDays))
+			}
+			if vYears, ok := mDefaultRetention["years"].(int); ok && vYears > 0 {
+				conf.Rule.DefaultRetention.Years = aws.Int64(int64(vYears))
+			}
+		}
+	}
+
+	return conf
+}
+
+func flattenS3ObjectLockConfiguration(conf *s3.ObjectLockConfiguration) []interface{} {
+	if conf == nil {
+		return []interface{}{}
+	}
+
+	mConf := map[string]interface{}{
+		"object_lock_enabled": aws.StringValue(conf.ObjectLockEnabled),
+	}
+
+	if conf.Rule != nil && conf.Rule.DefaultRetention != nil {
+		mRule := map[string]interface{}{
+			"default_retention": []interface{}{
+				map[string]interface{}{
+					"mode":  aws.StringValue(conf.Rule.DefaultRetention.Mode),
+					"days":  int(aws.Int64Value(conf.Rule.DefaultRetention.Days)),
+					"years": int(aws.Int64Value(conf.Rule.DefaultRetention.Years)),
+				},
+			},
+		}
+
+		mConf["rule"] = []interface{}{mRule}
+	}
+
+	return []interface{}{mConf}
+}
+
 func normalizeRoutingRules(w []*s3.RoutingRule) (string, error) {
 	withNulls, err := json.Marshal(w)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -24,7 +25,7 @@ func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectPut,
 		Read:   resourceAwsS3BucketObjectRead,
-		Update: resourceAwsS3BucketObjectPut,
+		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
 		CustomizeDiff: updateComputedAttributes,
@@ -152,6 +153,37 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"object_lock_legal_hold_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockLegalHoldStatusOn,
+					s3.ObjectLockLegalHoldStatusOff,
+				}, false),
+			},
+
+			"object_lock_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockModeGovernance,
+					s3.ObjectLockModeCompliance,
+				}, false),
+			},
+
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -241,7 +273,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		if restricted {
 			return fmt.Errorf("This region does not allow for tags on S3 objects")
 		}
@@ -258,6 +290,18 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		putInput.ObjectLockLegalHoldStatus = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		putInput.ObjectLockMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+		putInput.ObjectLockRetainUntilDate = expandS3ObjectLockRetainUntilDate(v.(string))
+	}
+
 	resp, err := s3conn.PutObject(putInput)
 	if err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
@@ -304,6 +348,9 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version_id", resp.VersionId)
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
+	d.Set("object_lock_legal_hold_status", resp.ObjectLockLegalHoldStatus)
+	d.Set("object_lock_mode", resp.ObjectLockMode)
+	d.Set("object_lock_retain_until_date", flattenS3ObjectLockRetainUntilDate(resp.ObjectLockRetainUntilDate))
 
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
@@ -345,11 +392,77 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Changes to any of these arguments require uploading a new object version.
+	if hasS3BucketObjectContentChanges(d) {
+		return resourceAwsS3BucketObjectPut(d, meta)
+	}
+
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	if d.HasChange("object_lock_legal_hold_status") {
+		status := d.Get("object_lock_legal_hold_status").(string)
+		if status == "" {
+			status = s3.ObjectLockLegalHoldStatusOff
+		}
+
+		_, err := s3conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: aws.String(d.Get("version_id").(string)),
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(status),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error putting S3 object lock legal hold (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
+	if d.HasChange("object_lock_mode") || d.HasChange("object_lock_retain_until_date") {
+		req := &s3.PutObjectRetentionInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: aws.String(d.Get("version_id").(string)),
+			Retention: &s3.ObjectLockRetention{},
+		}
+
+		if v, ok := d.GetOk("object_lock_mode"); ok {
+			req.Retention.Mode = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+			req.Retention.RetainUntilDate = expandS3ObjectLockRetainUntilDate(v.(string))
+		}
+
+		// Shortening or removing governance mode retention requires bypassing it.
+		if d.HasChange("object_lock_retain_until_date") {
+			o, n := d.GetChange("object_lock_retain_until_date")
+			oDate := expandS3ObjectLockRetainUntilDate(o.(string))
+			nDate := expandS3ObjectLockRetainUntilDate(n.(string))
+			if nDate == nil || (oDate != nil && nDate.Before(*oDate)) {
+				req.BypassGovernanceRetention = aws.Bool(true)
+			}
+		}
+
+		_, err := s3conn.PutObjectRetention(req)
+		if err != nil {
+			return fmt.Errorf("error putting S3 object lock retention (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	force := d.Get("force_destroy").(bool)
 
 	if _, ok := d.GetOk("version_id"); ok {
 		// Bucket is versioned, we need to delete all versions
@@ -363,13 +476,12 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 
 		for _, v := range out.Versions {
-			input := s3.DeleteObjectInput{
-				Bucket:    aws.String(bucket),
-				Key:       aws.String(key),
-				VersionId: v.VersionId,
+			// The prefix also matches other objects whose keys start with this key.
+			if aws.StringValue(v.Key) != key {
+				continue
 			}
-			_, err := s3conn.DeleteObject(&input)
-			if err != nil {
+
+			if err := deleteS3ObjectVersion(s3conn, bucket, key, aws.StringValue(v.VersionId), force); err != nil {
 				return fmt.Errorf("Error deleting S3 object version of %s:\n %s:\n %s",
 					key, v, err)
 			}
@@ -388,3 +500,91 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 
 	return nil
 }
+
+// deleteS3ObjectVersion deletes a single object version. S3 refuses to delete
+// versions that are under a legal hold or retention period; when force is set,
+// any legal hold is removed and governance mode retention is bypassed first.
+// Versions locked in compliance mode cannot be deleted until retention expires.
+func deleteS3ObjectVersion(s3conn *s3.S3, bucket, key, versionId string, force bool) error {
+	input := &s3.DeleteObjectInput{
+		Bucket:    aws.String(bucket),
+		Key:       aws.String(key),
+		VersionId: aws.String(versionId),
+	}
+
+	if force {
+		legalHold, err := s3conn.GetObjectLegalHold(&s3.GetObjectLegalHoldInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: aws.String(versionId),
+		})
+		// Buckets without S3 Object Lock enabled have no legal holds.
+		if err != nil && !isAWSErr(err, "InvalidRequest", "") && !isAWSErr(err, "NoSuchObjectLockConfiguration", "") {
+			return fmt.Errorf("error getting legal hold: %s", err)
+		}
+
+		if legalHold != nil && legalHold.LegalHold != nil && aws.StringValue(legalHold.LegalHold.Status) == s3.ObjectLockLegalHoldStatusOn {
+			log.Printf("[DEBUG] Removing legal hold from S3 object %s version %s", key, versionId)
+			_, err := s3conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+				Bucket:    aws.String(bucket),
+				Key:       aws.String(key),
+				VersionId: aws.String(versionId),
+				LegalHold: &s3.ObjectLockLegalHold{
+					Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error removing legal hold: %s", err)
+			}
+		}
+
+		input.BypassGovernanceRetention = aws.Bool(true)
+	}
+
+	_, err := s3conn.DeleteObject(input)
+
+	return err
+}
+
+func hasS3BucketObjectContentChanges(d *schema.ResourceData) bool {
+	for _, key := range []string{
+		"acl",
+		"cache_control",
+		"content",
+		"content_base64",
+		"content_disposition",
+		"content_encoding",
+		"content_language",
+		"content_type",
+		"etag",
+		"kms_key_id",
+		"server_side_encryption",
+		"source",
+		"storage_class",
+		"tags",
+		"tags_all",
+		"website_redirect",
+	} {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func expandS3ObjectLockRetainUntilDate(v string) *time.Time {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil
+	}
+
+	return aws.Time(t)
+}
+
+func flattenS3ObjectLockRetainUntilDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
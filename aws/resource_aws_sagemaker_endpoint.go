@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointCreate,
+		Read:   resourceAwsSagemakerEndpointRead,
+		Update: resourceAwsSagemakerEndpointUpdate,
+		Delete: resourceAwsSagemakerEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"endpoint_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateEndpointInput{
+		EndpointName:       aws.String(name),
+		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		createOpts.Tags = tagsFromMapSageMaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Sagemaker endpoint create config: %#v", *createOpts)
+	_, err := conn.CreateEndpoint(createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating Sagemaker endpoint: %s", err)
+	}
+
+	d.SetId(name)
+
+	describeInput := &sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(name),
+	}
+
+	if err := conn.WaitUntilEndpointInService(describeInput); err != nil {
+		return fmt.Errorf("error waiting for Sagemaker endpoint (%s) to be in service: %s", name, err)
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	endpoint, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+		log.Printf("[WARN] Sagemaker endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Sagemaker endpoint (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(endpoint.EndpointStatus) == sagemaker.EndpointStatusDeleting {
+		log.Printf("[WARN] Sagemaker endpoint (%s) is deleting, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", endpoint.EndpointArn)
+	d.Set("name", endpoint.EndpointName)
+	d.Set("endpoint_config_name", endpoint.EndpointConfigName)
+
+	if err := getTagsSageMaker(conn, d); err != nil {
+		return fmt.Errorf("error listing tags for Sagemaker endpoint (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if d.HasChange("tags_all") {
+		if err := setTagsSageMaker(conn, d); err != nil {
+			return fmt.Errorf("error updating Sagemaker endpoint (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	if d.HasChange("endpoint_config_name") {
+		modifyOpts := &sagemaker.UpdateEndpointInput{
+			EndpointName:       aws.String(d.Id()),
+			EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		}
+
+		log.Printf("[INFO] Modifying endpoint_config_name attribute for %s: %#v", d.Id(), modifyOpts)
+		if _, err := conn.UpdateEndpoint(modifyOpts); err != nil {
+			return fmt.Errorf("error updating Sagemaker endpoint (%s): %s", d.Id(), err)
+		}
+
+		describeInput := &sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(d.Id()),
+		}
+
+		if err := conn.WaitUntilEndpointInService(describeInput); err != nil {
+			return fmt.Errorf("error waiting for Sagemaker endpoint (%s) to be in service: %s", d.Id(), err)
+		}
+
+		d.SetPartial("endpoint_config_name")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	deleteEndpointOpts := &sagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	}
+
+	log.Printf("[INFO] Deleting Sagemaker endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(deleteEndpointOpts)
+	if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Sagemaker endpoint (%s): %s", d.Id(), err)
+	}
+
+	describeInput := &sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	}
+
+	if err := conn.WaitUntilEndpointDeleted(describeInput); err != nil {
+		return fmt.Errorf("error waiting for Sagemaker endpoint (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointConfigurationCreate,
+		Read:   resourceAwsSagemakerEndpointConfigurationRead,
+		Update: resourceAwsSagemakerEndpointConfigurationUpdate,
+		Delete: resourceAwsSagemakerEndpointConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"production_variants": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"variant_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
+						"model_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"initial_instance_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"initial_variant_weight": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							ForceNew: true,
+							Default:  1,
+						},
+
+						"accelerator_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								sagemaker.ProductionVariantAcceleratorTypeMlEia1Medium,
+								sagemaker.ProductionVariantAcceleratorTypeMlEia1Large,
+								sagemaker.ProductionVariantAcceleratorTypeMlEia1Xlarge,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateEndpointConfigInput{
+		EndpointConfigName: aws.String(name),
+		ProductionVariants: expandSagemakerProductionVariants(d.Get("production_variants").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		createOpts.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		createOpts.Tags = tagsFromMapSageMaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Sagemaker endpoint configuration create config: %#v", *createOpts)
+	_, err := conn.CreateEndpointConfig(createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating Sagemaker endpoint configuration: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	endpointConfig, err := conn.DescribeEndpointConfig(&sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+		log.Printf("[WARN] Sagemaker endpoint configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Sagemaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", endpointConfig.EndpointConfigArn)
+	d.Set("name", endpointConfig.EndpointConfigName)
+	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
+
+	if err := d.Set("production_variants", flattenSagemakerProductionVariants(endpointConfig.ProductionVariants)); err != nil {
+		return fmt.Errorf("error setting production_variants: %s", err)
+	}
+
+	if err := getTagsSageMaker(conn, d); err != nil {
+		return fmt.Errorf("error listing tags for Sagemaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if d.HasChange("tags_all") {
+		if err := setTagsSageMaker(conn, d); err != nil {
+			return fmt.Errorf("error updating Sagemaker endpoint configuration (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	deleteOpts := &sagemaker.DeleteEndpointConfigInput{
+		EndpointConfigName: aws.String(d.Id()),
+	}
+
+	log.Printf("[INFO] Deleting Sagemaker endpoint configuration: %s", d.Id())
+	_, err := conn.DeleteEndpointConfig(deleteOpts)
+	if isAWSErr(err, "ValidationException", "Could not find endpoint configuration") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Sagemaker endpoint configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerProductionVariants(configured []interface{}) []*sagemaker.ProductionVariant {
+	containers := make([]*sagemaker.ProductionVariant, 0, len(configured))
+
+	for _, lRaw := range configured {
+		data := lRaw.(map[string]interface{})
+
+		l := &sagemaker.ProductionVariant{
+			InstanceType:         aws.String(data["instance_type"].(string)),
+			ModelName:            aws.String(data["model_name"].(string)),
+			InitialInstanceCount: aws.Int64(int64(data["initial_instance_count"].(int))),
+		}
+
+		if v, ok := data["variant_name"].(string); ok && v != "" {
+			l.VariantName = aws.String(v)
+		} else {
+			l.VariantName = aws.String(resource.UniqueId())
+		}
+
+		if v, ok := data["initial_variant_weight"].(float64); ok {
+			l.InitialVariantWeight = aws.Float64(v)
+		}
+
+		if v, ok := data["accelerator_type"].(string); ok && v != "" {
+			l.AcceleratorType = aws.String(v)
+		}
+
+		containers = append(containers, l)
+	}
+
+	return containers
+}
+
+func flattenSagemakerProductionVariants(list []*sagemaker.ProductionVariant) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+
+	for _, i := range list {
+		l := map[string]interface{}{
+			"accelerator_type":       aws.StringValue(i.AcceleratorType),
+			"initial_instance_count": int(aws.Int64Value(i.InitialInstanceCount)),
+			"initial_variant_weight": aws.Float64Value(i.InitialVariantWeight),
+			"instance_type":          aws.StringValue(i.InstanceType),
+			"model_name":             aws.StringValue(i.ModelName),
+			"variant_name":           aws.StringValue(i.VariantName),
+		}
+
+		result = append(result, l)
+	}
+
+	return result
+}
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+		Name: "aws_sagemaker_endpoint",
+		F:    testSweepSagemakerEndpoints,
+	})
+}
+
+func testSweepSagemakerEndpoints(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+
+	input := &sagemaker.ListEndpointsInput{
+		NameContains: aws.String("tf-acc-test"),
+	}
+	err = conn.ListEndpointsPages(input, func(page *sagemaker.ListEndpointsOutput, lastPage bool) bool {
+		for _, endpoint := range page.Endpoints {
+			name := aws.StringValue(endpoint.EndpointName)
+
+			if !strings.HasPrefix(name, "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Sagemaker endpoint: %s", name)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting Sagemaker endpoint: %s", name)
+			_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+				EndpointName: aws.String(name),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Sagemaker endpoint %s: %s", name, err)
+				continue
+			}
+
+			err = conn.WaitUntilEndpointDeleted(&sagemaker.DescribeEndpointInput{
+				EndpointName: aws.String(name),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to wait for Sagemaker endpoint %s deletion: %s", name, err)
+			}
+		}
+
+		return !lastPage
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Sagemaker endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving Sagemaker endpoints: %s", err)
+	}
+
+	return nil
+}
+
+// testAccSagemakerEndpointModelDataUrl returns the S3 location of trained
+// k-means model artifacts, which an endpoint needs in order to come into
+// service, or skips the test if it has not been configured.
+func testAccSagemakerEndpointModelDataUrl(t *testing.T) string {
+	modelDataUrl := os.Getenv("SAGEMAKER_KMEANS_MODEL_DATA_URL")
+	if modelDataUrl == "" {
+		t.Skip(
+			"Environment variable SAGEMAKER_KMEANS_MODEL_DATA_URL is not set. " +
+				"This environment variable must be set to the S3 URL of " +
+				"trained k-means model artifacts in the test region to enable this test.")
+	}
+
+	return modelDataUrl
+}
+
+func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
+	modelDataUrl := testAccSagemakerEndpointModelDataUrl(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfig(rName, modelDataUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("endpoint/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_endpointConfigName(t *testing.T) {
+	modelDataUrl := testAccSagemakerEndpointModelDataUrl(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfig(rName, modelDataUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.test", "name"),
+				),
+			},
+			{
+				Config: testAccSagemakerEndpointConfigEndpointConfigNameUpdated(rName, modelDataUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.updated", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_tags(t *testing.T) {
+	modelDataUrl := testAccSagemakerEndpointModelDataUrl(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfigTags(rName, modelDataUrl, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccSagemakerEndpointConfigTags(rName, modelDataUrl, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSagemakerEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint" {
+			continue
+		}
+
+		_, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, "ValidationException", "Could not find endpoint") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Sagemaker endpoint (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSagemakerEndpointExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Sagemaker endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		_, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccSagemakerEndpointConfigBase(rName, modelDataUrl string) string {
+	return testAccSagemakerModelConfigBase(rName) + fmt.Sprintf(`
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"
+}
+
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = "${aws_iam_role.test.arn}"
+
+  primary_container {
+    image          = "${local.kmeans_image}"
+    model_data_url = %[2]q
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = %[1]q
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+`, rName, modelDataUrl)
+}
+
+func testAccSagemakerEndpointConfig(rName, modelDataUrl string) string {
+	return testAccSagemakerEndpointConfigBase(rName, modelDataUrl) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.test.name}"
+}
+`, rName)
+}
+
+func testAccSagemakerEndpointConfigEndpointConfigNameUpdated(rName, modelDataUrl string) string {
+	return testAccSagemakerEndpointConfigBase(rName, modelDataUrl) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "updated" {
+  name = "%[1]s-updated"
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+  }
+}
+
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.updated.name}"
+}
+`, rName)
+}
+
+func testAccSagemakerEndpointConfigTags(rName, modelDataUrl, tagValue string) string {
+	return testAccSagemakerEndpointConfigBase(rName, modelDataUrl) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.test.name}"
+
+  tags = {
+    foo = %[2]q
+  }
+}
+`, rName, tagValue)
+}
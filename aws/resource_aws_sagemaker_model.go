@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerModelCreate,
+		Read:   resourceAwsSagemakerModelRead,
+		Update: resourceAwsSagemakerModelUpdate,
+		Delete: resourceAwsSagemakerModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"primary_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_hostname": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSagemakerName,
+						},
+
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"model_data_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"vpc_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"enable_network_isolation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	createOpts := &sagemaker.CreateModelInput{
+		ModelName:        aws.String(name),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		PrimaryContainer: expandSagemakerContainer(d.Get("primary_container").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		createOpts.VpcConfig = expandSagemakerVpcConfigRequest(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("enable_network_isolation"); ok {
+		createOpts.EnableNetworkIsolation = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		createOpts.Tags = tagsFromMapSageMaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Sagemaker model create config: %#v", *createOpts)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateModel(createOpts)
+		// IAM is eventually consistent, so the execution role may not be
+		// assumable by SageMaker immediately after it is created.
+		if isAWSErr(err, "ValidationException", "Could not assume role") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Sagemaker model: %s", err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	model, err := conn.DescribeModel(&sagemaker.DescribeModelInput{
+		ModelName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "Could not find model") {
+		log.Printf("[WARN] Sagemaker model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Sagemaker model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", model.ModelArn)
+	d.Set("enable_network_isolation", model.EnableNetworkIsolation)
+	d.Set("execution_role_arn", model.ExecutionRoleArn)
+	d.Set("name", model.ModelName)
+
+	if err := d.Set("primary_container", flattenSagemakerContainer(model.PrimaryContainer)); err != nil {
+		return fmt.Errorf("error setting primary_container: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSagemakerVpcConfigResponse(model.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %s", err)
+	}
+
+	if err := getTagsSageMaker(conn, d); err != nil {
+		return fmt.Errorf("error listing tags for Sagemaker model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if d.HasChange("tags_all") {
+		if err := setTagsSageMaker(conn, d); err != nil {
+			return fmt.Errorf("error updating Sagemaker model (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerModelRead(d, meta)
+}
+
+func resourceAwsSagemakerModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	deleteOpts := &sagemaker.DeleteModelInput{
+		ModelName: aws.String(d.Id()),
+	}
+
+	log.Printf("[INFO] Deleting Sagemaker model: %s", d.Id())
+	_, err := conn.DeleteModel(deleteOpts)
+	if isAWSErr(err, "ValidationException", "Could not find model") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Sagemaker model (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerContainer(l []interface{}) *sagemaker.ContainerDefinition {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	container := &sagemaker.ContainerDefinition{
+		Image: aws.String(m["image"].(string)),
+	}
+
+	if v, ok := m["container_hostname"].(string); ok && v != "" {
+		container.ContainerHostname = aws.String(v)
+	}
+
+	if v, ok := m["model_data_url"].(string); ok && v != "" {
+		container.ModelDataUrl = aws.String(v)
+	}
+
+	if v, ok := m["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		container.Environment = stringMapToPointers(v)
+	}
+
+	return container
+}
+
+func flattenSagemakerContainer(container *sagemaker.ContainerDefinition) []interface{} {
+	if container == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_hostname": aws.StringValue(container.ContainerHostname),
+		"environment":        pointersMapToStringList(container.Environment),
+		"image":              aws.StringValue(container.Image),
+		"model_data_url":     aws.StringValue(container.ModelDataUrl),
+	}
+
+	return []interface{}{m}
+}
+
+func expandSagemakerVpcConfigRequest(l []interface{}) *sagemaker.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &sagemaker.VpcConfig{
+		SecurityGroupIds: expandStringSet(m["security_group_ids"].(*schema.Set)),
+		Subnets:          expandStringSet(m["subnets"].(*schema.Set)),
+	}
+}
+
+func flattenSagemakerVpcConfigResponse(vpcConfig *sagemaker.VpcConfig) []interface{} {
+	if vpcConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"security_group_ids": schema.NewSet(schema.HashString, flattenStringList(vpcConfig.SecurityGroupIds)),
+		"subnets":            schema.NewSet(schema.HashString, flattenStringList(vpcConfig.Subnets)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSagemakerNotebookInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceRead,
+		Update: resourceAwsSagemakerNotebookInstanceUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lifecycle_config_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := d.Get("name").(string)
+
+	createOpts := &sagemaker.CreateNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		InstanceType:         aws.String(d.Get("instance_type").(string)),
+	}
+
+	if v, ok := d.GetOk("security_groups"); ok {
+		createOpts.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		createOpts.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		createOpts.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lifecycle_config_name"); ok {
+		createOpts.LifecycleConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
+		createOpts.Tags = tagsFromMapSageMaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Sagemaker notebook instance create config: %#v", *createOpts)
+	_, err := conn.CreateNotebookInstance(createOpts)
+	if err != nil {
+		return fmt.Errorf("error creating Sagemaker notebook instance: %s", err)
+	}
+
+	d.SetId(name)
+
+	describeInput := &sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	}
+
+	if err := conn.WaitUntilNotebookInstanceInService(describeInput); err != nil {
+		return fmt.Errorf("error waiting for Sagemaker notebook instance (%s) to be in service: %s", name, err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	notebookInstance, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "RecordNotFound") {
+		log.Printf("[WARN] Sagemaker notebook instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Sagemaker notebook instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", notebookInstance.NotebookInstanceArn)
+	d.Set("name", notebookInstance.NotebookInstanceName)
+	d.Set("role_arn", notebookInstance.RoleArn)
+	d.Set("instance_type", notebookInstance.InstanceType)
+	d.Set("subnet_id", notebookInstance.SubnetId)
+	d.Set("kms_key_id", notebookInstance.KmsKeyId)
+	d.Set("lifecycle_config_name", notebookInstance.NotebookInstanceLifecycleConfigName)
+
+	if err := d.Set("security_groups", flattenStringSet(notebookInstance.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_groups: %s", err)
+	}
+
+	if err := getTagsSageMaker(conn, d); err != nil {
+		return fmt.Errorf("error listing tags for Sagemaker notebook instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	d.Partial(true)
+
+	if d.HasChange("tags_all") {
+		if err := setTagsSageMaker(conn, d); err != nil {
+			return fmt.Errorf("error updating Sagemaker notebook instance (%s) tags: %s", d.Id(), err)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	if d.HasChange("role_arn") || d.HasChange("instance_type") || d.HasChange("lifecycle_config_name") {
+		updateOpts := &sagemaker.UpdateNotebookInstanceInput{
+			NotebookInstanceName: aws.String(d.Id()),
+			RoleArn:              aws.String(d.Get("role_arn").(string)),
+			InstanceType:         aws.String(d.Get("instance_type").(string)),
+		}
+
+		if d.HasChange("lifecycle_config_name") {
+			if v, ok := d.GetOk("lifecycle_config_name"); ok {
+				updateOpts.LifecycleConfigName = aws.String(v.(string))
+			} else {
+				updateOpts.DisassociateLifecycleConfig = aws.Bool(true)
+			}
+		}
+
+		// A notebook instance can only be updated while it is stopped.
+		if err := stopSagemakerNotebookInstance(conn, d.Id()); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Updating Sagemaker notebook instance: %#v", updateOpts)
+		if _, err := conn.UpdateNotebookInstance(updateOpts); err != nil {
+			return fmt.Errorf("error updating Sagemaker notebook instance (%s): %s", d.Id(), err)
+		}
+
+		describeInput := &sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(d.Id()),
+		}
+
+		if err := conn.WaitUntilNotebookInstanceStopped(describeInput); err != nil {
+			return fmt.Errorf("error waiting for Sagemaker notebook instance (%s) to stop after update: %s", d.Id(), err)
+		}
+
+		if _, err := conn.StartNotebookInstance(&sagemaker.StartNotebookInstanceInput{
+			NotebookInstanceName: aws.String(d.Id()),
+		}); err != nil {
+			return fmt.Errorf("error starting Sagemaker notebook instance (%s): %s", d.Id(), err)
+		}
+
+		if err := conn.WaitUntilNotebookInstanceInService(describeInput); err != nil {
+			return fmt.Errorf("error waiting for Sagemaker notebook instance (%s) to be in service: %s", d.Id(), err)
+		}
+
+		d.SetPartial("role_arn")
+		d.SetPartial("instance_type")
+		d.SetPartial("lifecycle_config_name")
+	}
+
+	d.Partial(false)
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := stopSagemakerNotebookInstance(conn, d.Id()); err != nil {
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Sagemaker notebook instance: %s", d.Id())
+	_, err := conn.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, "ValidationException", "RecordNotFound") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Sagemaker notebook instance (%s): %s", d.Id(), err)
+	}
+
+	describeInput := &sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	}
+
+	if err := conn.WaitUntilNotebookInstanceDeleted(describeInput); err != nil {
+		return fmt.Errorf("error waiting for Sagemaker notebook instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// stopSagemakerNotebookInstance stops the notebook instance if it is running
+// and waits for it to reach the Stopped state.
+func stopSagemakerNotebookInstance(conn *sagemaker.SageMaker, id string) error {
+	describeInput := &sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(id),
+	}
+
+	notebook, err := conn.DescribeNotebookInstance(describeInput)
+	if err != nil {
+		return err
+	}
+
+	switch aws.StringValue(notebook.NotebookInstanceStatus) {
+	case sagemaker.NotebookInstanceStatusStopped, sagemaker.NotebookInstanceStatusFailed:
+		return nil
+	case sagemaker.NotebookInstanceStatusPending, sagemaker.NotebookInstanceStatusUpdating:
+		if err := conn.WaitUntilNotebookInstanceInService(describeInput); err != nil {
+			return fmt.Errorf("error waiting for Sagemaker notebook instance (%s) to be in service: %s", id, err)
+		}
+		fallthrough
+	case sagemaker.NotebookInstanceStatusInService:
+		if _, err := conn.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+			NotebookInstanceName: aws.String(id),
+		}); err != nil {
+			return fmt.Errorf("error stopping Sagemaker notebook instance (%s): %s", id, err)
+		}
+	}
+
+	if err := conn.WaitUntilNotebookInstanceStopped(describeInput); err != nil {
+		return fmt.Errorf("error waiting for Sagemaker notebook instance (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
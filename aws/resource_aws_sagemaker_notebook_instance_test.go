@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+		Name: "aws_sagemaker_notebook_instance",
+		F:    testSweepSagemakerNotebookInstances,
+	})
+}
+
+func testSweepSagemakerNotebookInstances(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+
+	input := &sagemaker.ListNotebookInstancesInput{
+		NameContains: aws.String("tf-acc-test"),
+	}
+	err = conn.ListNotebookInstancesPages(input, func(page *sagemaker.ListNotebookInstancesOutput, lastPage bool) bool {
+		for _, instance := range page.NotebookInstances {
+			name := aws.StringValue(instance.NotebookInstanceName)
+
+			if !strings.HasPrefix(name, "tf-acc-test-") {
+				log.Printf("[INFO] Skipping Sagemaker notebook instance: %s", name)
+				continue
+			}
+
+			if err := stopSagemakerNotebookInstance(conn, name); err != nil {
+				log.Printf("[ERROR] Failed to stop Sagemaker notebook instance %s: %s", name, err)
+				continue
+			}
+
+			log.Printf("[INFO] Deleting Sagemaker notebook instance: %s", name)
+			_, err := conn.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+				NotebookInstanceName: aws.String(name),
+			})
+			if err != nil {
+				log.Printf("[ERROR] Failed to delete Sagemaker notebook instance %s: %s", name, err)
+			}
+		}
+
+		return !lastPage
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Sagemaker notebook instance sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving Sagemaker notebook instances: %s", err)
+	}
+
+	return nil
+}
+
+func TestAccAWSSagemakerNotebookInstance_basic(t *testing.T) {
+	var notebook sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerNotebookInstanceConfig(rName, "ml.t2.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerNotebookInstanceExists(resourceName, &notebook),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("notebook-instance/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.medium"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstance_update(t *testing.T) {
+	var notebook sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerNotebookInstanceConfig(rName, "ml.t2.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerNotebookInstanceExists(resourceName, &notebook),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.medium"),
+				),
+			},
+			{
+				Config: testAccSagemakerNotebookInstanceConfig(rName, "ml.t2.large"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerNotebookInstanceExists(resourceName, &notebook),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "ml.t2.large"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerNotebookInstance_tags(t *testing.T) {
+	var notebook sagemaker.DescribeNotebookInstanceOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "aws_sagemaker_notebook_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerNotebookInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerNotebookInstanceConfigTags(rName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerNotebookInstanceExists(resourceName, &notebook),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccSagemakerNotebookInstanceConfigTags(rName, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerNotebookInstanceExists(resourceName, &notebook),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSagemakerNotebookInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_notebook_instance" {
+			continue
+		}
+
+		_, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, "ValidationException", "RecordNotFound") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Sagemaker notebook instance (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckSagemakerNotebookInstanceExists(n string, v *sagemaker.DescribeNotebookInstanceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Sagemaker notebook instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		resp, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccSagemakerNotebookInstanceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  path               = "/"
+  assume_role_policy = "${data.aws_iam_policy_document.assume_role.json}"
+}
+`, rName)
+}
+
+func testAccSagemakerNotebookInstanceConfig(rName, instanceType string) string {
+	return testAccSagemakerNotebookInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance" "test" {
+  name          = %[1]q
+  role_arn      = "${aws_iam_role.test.arn}"
+  instance_type = %[2]q
+}
+`, rName, instanceType)
+}
+
+func testAccSagemakerNotebookInstanceConfigTags(rName, tagValue string) string {
+	return testAccSagemakerNotebookInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance" "test" {
+  name          = %[1]q
+  role_arn      = "${aws_iam_role.test.arn}"
+  instance_type = "ml.t2.medium"
+
+  tags = {
+    foo = %[2]q
+  }
+}
+`, rName, tagValue)
+}
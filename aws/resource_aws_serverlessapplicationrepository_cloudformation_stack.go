@@ -0,0 +1,415 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// The Serverless Application Repository prefixes the names of the
+	// CloudFormation stacks it creates.
+	serverlessRepositoryCloudFormationStackNamePrefix = "serverlessrepo-"
+
+	serverlessRepositoryCloudFormationStackTagApplicationId   = "serverlessrepo:applicationId"
+	serverlessRepositoryCloudFormationStackTagSemanticVersion = "serverlessrepo:semanticVersion"
+)
+
+func resourceAwsServerlessRepositoryCloudFormationStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServerlessRepositoryCloudFormationStackCreate,
+		Read:   resourceAwsServerlessRepositoryCloudFormationStackRead,
+		Update: resourceAwsServerlessRepositoryCloudFormationStackUpdate,
+		Delete: resourceAwsServerlessRepositoryCloudFormationStackDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+						cloudformation.CapabilityCapabilityAutoExpand,
+						"CAPABILITY_RESOURCE_POLICY",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsServerlessRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := createServerlessRepositoryCloudFormationChangeSet(d, meta.(*AWSClient).serverlessrepoconn)
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation change set: %s", err)
+	}
+
+	d.SetId(aws.StringValue(changeSet.StackId))
+
+	hasChanges, err := waitForServerlessRepositoryCloudFormationChangeSet(cfConn, aws.StringValue(changeSet.ChangeSetId), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation change set (%s) creation: %s", aws.StringValue(changeSet.ChangeSetId), err)
+	}
+
+	if hasChanges {
+		if err := executeServerlessRepositoryCloudFormationChangeSet(cfConn, aws.StringValue(changeSet.ChangeSetId)); err != nil {
+			return err
+		}
+	}
+
+	var lastStatus string
+	wait := resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackStatusReviewInProgress,
+			cloudformation.StackStatusCreateInProgress,
+			cloudformation.StackStatusRollbackInProgress,
+		},
+		Target: []string{
+			cloudformation.StackStatusCreateComplete,
+			cloudformation.StackStatusCreateFailed,
+			cloudformation.StackStatusRollbackComplete,
+			cloudformation.StackStatusRollbackFailed,
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, status, err := serverlessRepositoryCloudFormationStackStatus(cfConn, d.Id())
+			lastStatus = status
+			return resp, status, err
+		},
+	}
+
+	if _, err := wait.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation stack (%s) creation: %s", d.Id(), err)
+	}
+
+	if lastStatus == cloudformation.StackStatusRollbackComplete || lastStatus == cloudformation.StackStatusRollbackFailed {
+		reasons, err := getCloudFormationRollbackReasons(d.Id(), nil, cfConn)
+		if err != nil {
+			return fmt.Errorf("Failed getting rollback reasons: %q", err.Error())
+		}
+
+		return fmt.Errorf("%s: %q", lastStatus, reasons)
+	}
+	if lastStatus == cloudformation.StackStatusCreateFailed {
+		reasons, err := getCloudFormationFailures(d.Id(), cfConn)
+		if err != nil {
+			return fmt.Errorf("Failed getting failure reasons: %q", err.Error())
+		}
+
+		return fmt.Errorf("%s: %q", lastStatus, reasons)
+	}
+
+	log.Printf("[INFO] Serverless Application Repository CloudFormation Stack %q created", d.Id())
+
+	return resourceAwsServerlessRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(d.Id()),
+	})
+	// ValidationError: Stack with id % does not exist
+	if isAWSErr(err, "ValidationError", "") {
+		log.Printf("[WARN] Serverless Application Repository CloudFormation stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error describing Serverless Application Repository CloudFormation stack (%s): %s", d.Id(), err)
+	}
+
+	if len(resp.Stacks) == 0 || aws.StringValue(resp.Stacks[0].StackStatus) == cloudformation.StackStatusDeleteComplete {
+		log.Printf("[WARN] Serverless Application Repository CloudFormation stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	stack := resp.Stacks[0]
+
+	d.Set("name", strings.TrimPrefix(aws.StringValue(stack.StackName), serverlessRepositoryCloudFormationStackNamePrefix))
+
+	tags := make(map[string]string)
+	for _, t := range stack.Tags {
+		key := aws.StringValue(t.Key)
+		switch {
+		case key == serverlessRepositoryCloudFormationStackTagApplicationId:
+			d.Set("application_id", t.Value)
+		case key == serverlessRepositoryCloudFormationStackTagSemanticVersion:
+			d.Set("semantic_version", t.Value)
+		case strings.HasPrefix(key, "aws:"):
+			continue
+		default:
+			tags[key] = aws.StringValue(t.Value)
+		}
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	// Only track configured parameters to avoid clashing with template
+	// defaults, unless none are configured (e.g. on import).
+	var parameters map[string]interface{}
+	if originalParams := d.Get("parameters").(map[string]interface{}); len(originalParams) > 0 {
+		parameters = flattenCloudFormationParameters(stack.Parameters, originalParams)
+	} else {
+		parameters = flattenAllCloudFormationParameters(stack.Parameters)
+	}
+
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %s", err)
+	}
+
+	if err := d.Set("capabilities", schema.NewSet(schema.HashString, flattenStringList(stack.Capabilities))); err != nil {
+		return fmt.Errorf("error setting capabilities: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := createServerlessRepositoryCloudFormationChangeSet(d, meta.(*AWSClient).serverlessrepoconn)
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation change set: %s", err)
+	}
+
+	hasChanges, err := waitForServerlessRepositoryCloudFormationChangeSet(cfConn, aws.StringValue(changeSet.ChangeSetId), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation change set (%s) creation: %s", aws.StringValue(changeSet.ChangeSetId), err)
+	}
+
+	if !hasChanges {
+		log.Printf("[DEBUG] Serverless Application Repository CloudFormation stack (%s) has no updates", d.Id())
+		return resourceAwsServerlessRepositoryCloudFormationStackRead(d, meta)
+	}
+
+	lastUpdatedTime, err := getLastCfEventTimestamp(d.Id(), cfConn)
+	if err != nil {
+		return err
+	}
+
+	if err := executeServerlessRepositoryCloudFormationChangeSet(cfConn, aws.StringValue(changeSet.ChangeSetId)); err != nil {
+		return err
+	}
+
+	var lastStatus string
+	wait := resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackStatusUpdateCompleteCleanupInProgress,
+			cloudformation.StackStatusUpdateInProgress,
+			cloudformation.StackStatusUpdateRollbackInProgress,
+			cloudformation.StackStatusUpdateRollbackCompleteCleanupInProgress,
+		},
+		Target: []string{
+			cloudformation.StackStatusUpdateComplete,
+			cloudformation.StackStatusUpdateRollbackComplete,
+			cloudformation.StackStatusUpdateRollbackFailed,
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, status, err := serverlessRepositoryCloudFormationStackStatus(cfConn, d.Id())
+			lastStatus = status
+			return resp, status, err
+		},
+	}
+
+	if _, err := wait.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation stack (%s) update: %s", d.Id(), err)
+	}
+
+	if lastStatus == cloudformation.StackStatusUpdateRollbackComplete || lastStatus == cloudformation.StackStatusUpdateRollbackFailed {
+		reasons, err := getCloudFormationRollbackReasons(d.Id(), lastUpdatedTime, cfConn)
+		if err != nil {
+			return fmt.Errorf("Failed getting details about rollback: %q", err.Error())
+		}
+
+		return fmt.Errorf("%s: %q", lastStatus, reasons)
+	}
+
+	log.Printf("[DEBUG] Serverless Application Repository CloudFormation stack %q has been updated", d.Id())
+
+	return resourceAwsServerlessRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
+	// The stack is a regular CloudFormation stack once deployed.
+	return resourceAwsCloudFormationStackDelete(d, meta)
+}
+
+func createServerlessRepositoryCloudFormationChangeSet(d *schema.ResourceData, conn *serverlessapplicationrepository.ServerlessApplicationRepository) (*serverlessapplicationrepository.CreateCloudFormationChangeSetOutput, error) {
+	input := &serverlessapplicationrepository.CreateCloudFormationChangeSetRequest{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		StackName:     aws.String(d.Get("name").(string)),
+		Capabilities:  expandStringSet(d.Get("capabilities").(*schema.Set)),
+		Tags:          expandServerlessRepositoryTags(d.Get("tags_all").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("semantic_version"); ok {
+		input.SemanticVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.ParameterOverrides = expandServerlessRepositoryParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Serverless Application Repository CloudFormation change set: %s", input)
+	return conn.CreateCloudFormationChangeSet(input)
+}
+
+// waitForServerlessRepositoryCloudFormationChangeSet waits for the change set
+// to be created, returning whether it contains any changes to execute.
+func waitForServerlessRepositoryCloudFormationChangeSet(conn *cloudformation.CloudFormation, changeSetId string, timeout time.Duration) (bool, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.ChangeSetStatusCreatePending,
+			cloudformation.ChangeSetStatusCreateInProgress,
+		},
+		Target: []string{
+			cloudformation.ChangeSetStatusCreateComplete,
+			cloudformation.ChangeSetStatusFailed,
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+				ChangeSetName: aws.String(changeSetId),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+
+			return resp, aws.StringValue(resp.Status), nil
+		},
+	}
+
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return false, err
+	}
+
+	resp := raw.(*cloudformation.DescribeChangeSetOutput)
+	if aws.StringValue(resp.Status) == cloudformation.ChangeSetStatusFailed {
+		reason := aws.StringValue(resp.StatusReason)
+		// A change set without any changes cannot be executed.
+		if strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed") {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("change set failed: %s", reason)
+	}
+
+	return true, nil
+}
+
+func executeServerlessRepositoryCloudFormationChangeSet(conn *cloudformation.CloudFormation, changeSetId string) error {
+	log.Printf("[DEBUG] Executing Serverless Application Repository CloudFormation change set: %s", changeSetId)
+	_, err := conn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: aws.String(changeSetId),
+	})
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation change set (%s): %s", changeSetId, err)
+	}
+
+	return nil
+}
+
+func serverlessRepositoryCloudFormationStackStatus(conn *cloudformation.CloudFormation, stackId string) (interface{}, string, error) {
+	resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackId),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(resp.Stacks) == 0 {
+		return nil, "", fmt.Errorf("CloudFormation stack %q vanished unexpectedly", stackId)
+	}
+
+	status := aws.StringValue(resp.Stacks[0].StackStatus)
+	log.Printf("[DEBUG] Current Serverless Application Repository CloudFormation stack status: %q", status)
+
+	return resp, status, nil
+}
+
+func expandServerlessRepositoryParameters(params map[string]interface{}) []*serverlessapplicationrepository.ParameterValue {
+	var values []*serverlessapplicationrepository.ParameterValue
+	for k, v := range params {
+		values = append(values, &serverlessapplicationrepository.ParameterValue{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return values
+}
+
+func expandServerlessRepositoryTags(tags map[string]interface{}) []*serverlessapplicationrepository.Tag {
+	var srTags []*serverlessapplicationrepository.Tag
+	for k, v := range tags {
+		srTags = append(srTags, &serverlessapplicationrepository.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return srTags
+}
@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+const testAccAwsServerlessRepositoryApplicationId = "arn:aws:serverlessrepo:us-east-1:297356227824:applications/SecretsManagerRDSPostgreSQLRotationSingleUser"
+
+func TestAccAwsServerlessRepositoryCloudFormationStack_basic(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "application_id", testAccAwsServerlessRepositoryApplicationId),
+					resource.TestCheckResourceAttrSet(resourceName, "semantic_version"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", "func-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "outputs.RotationLambdaARN"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessRepositoryCloudFormationStack_disappears(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack),
+					testAccCheckAwsServerlessRepositoryCloudFormationStackDisappears(&stack),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessRepositoryCloudFormationStack_versioned(t *testing.T) {
+	var stack1, stack2 cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfigVersioned(rName, "1.0.13"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack1),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", "1.0.13"),
+				),
+			},
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfigVersioned(rName, "1.1.36"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack2),
+					testAccCheckAwsServerlessRepositoryCloudFormationStackNotRecreated(&stack1, &stack2),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", "1.1.36"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessRepositoryCloudFormationStack_Tags(t *testing.T) {
+	var stack1, stack2, stack3 cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack2),
+					testAccCheckAwsServerlessRepositoryCloudFormationStackNotRecreated(&stack1, &stack2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsServerlessRepositoryCloudFormationStackConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName, &stack3),
+					testAccCheckAwsServerlessRepositoryCloudFormationStackNotRecreated(&stack2, &stack3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessRepositoryCloudFormationStack_InvalidCapability(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsServerlessRepositoryCloudFormationStackConfigCapabilities(rName, "CAPABILITY_INVALID"),
+				ExpectError: regexp.MustCompile(`expected capabilities\.\d+ to be one of`),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServerlessRepositoryCloudFormationStackExists(resourceName string, stack *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Stacks) == 0 {
+			return fmt.Errorf("CloudFormation stack %q not found", rs.Primary.ID)
+		}
+
+		*stack = *resp.Stacks[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAwsServerlessRepositoryCloudFormationStackDisappears(stack *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		_, err := conn.DeleteStack(&cloudformation.DeleteStackInput{
+			StackName: stack.StackId,
+		})
+		if err != nil {
+			return err
+		}
+
+		return conn.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{
+			StackName: stack.StackId,
+		})
+	}
+}
+
+func testAccCheckAwsServerlessRepositoryCloudFormationStackNotRecreated(i, j *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.StackId) != aws.StringValue(j.StackId) {
+			return errors.New("Serverless Application Repository CloudFormation stack was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsServerlessRepositoryCloudFormationStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_serverlessapplicationrepository_cloudformation_stack" {
+			continue
+		}
+
+		resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, "ValidationError", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, s := range resp.Stacks {
+			if aws.StringValue(s.StackId) == rs.Primary.ID && aws.StringValue(s.StackStatus) != cloudformation.StackStatusDeleteComplete {
+				return fmt.Errorf("CloudFormation stack still exists: %q", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsServerlessRepositoryCloudFormationStackConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.amazonaws.com"
+  }
+}
+`, rName, testAccAwsServerlessRepositoryApplicationId)
+}
+
+func testAccAwsServerlessRepositoryCloudFormationStackConfigVersioned(rName, version string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name             = %[1]q
+  application_id   = %[2]q
+  semantic_version = %[3]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.amazonaws.com"
+  }
+}
+`, rName, testAccAwsServerlessRepositoryApplicationId, version)
+}
+
+func testAccAwsServerlessRepositoryCloudFormationStackConfigCapabilities(rName, capability string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+  capabilities   = [%[3]q]
+}
+`, rName, testAccAwsServerlessRepositoryApplicationId, capability)
+}
+
+func testAccAwsServerlessRepositoryCloudFormationStackConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.amazonaws.com"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAwsServerlessRepositoryApplicationId, tagKey1, tagValue1)
+}
+
+func testAccAwsServerlessRepositoryCloudFormationStackConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.amazonaws.com"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAwsServerlessRepositoryApplicationId, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -27,13 +27,20 @@ func TestAccAWSSSMActivation_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_ssm_activation.foo", "activation_code"),
 				),
 			},
+			{
+				ResourceName:            "aws_ssm_activation.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_code"},
+			},
 		},
 	})
 }
 
 func TestAccAWSSSMActivation_expirationDate(t *testing.T) {
 	rName := acctest.RandString(10)
-	expirationTime := time.Now().Add(48 * time.Hour)
+	// Use a non-UTC offset, which is stored as the equivalent UTC timestamp
+	expirationTime := time.Now().Add(48 * time.Hour).In(time.FixedZone("UTC+2", 2*60*60))
 	expirationDateS := expirationTime.Format(time.RFC3339)
 	resourceName := "aws_ssm_activation.foo"
 
@@ -50,7 +57,7 @@ func TestAccAWSSSMActivation_expirationDate(t *testing.T) {
 				Config: testAccAWSSSMActivationConfig_expirationDate(rName, expirationDateS),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSSSMActivationExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "expiration_date", expirationDateS),
+					resource.TestCheckResourceAttr(resourceName, "expiration_date", expirationTime.UTC().Format(time.RFC3339)),
 				),
 			},
 		},
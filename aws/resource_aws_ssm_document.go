@@ -27,6 +27,9 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Read:   resourceAwsSsmDocumentRead,
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -196,7 +199,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading SSM Document: %s", d.Id())
 
 	docInput := &ssm.DescribeDocumentInput{
-		Name: aws.String(d.Get("name").(string)),
+		Name: aws.String(d.Id()),
 	}
 
 	resp, err := ssmconn.DescribeDocument(docInput)
@@ -210,15 +213,26 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	doc := resp.Document
+
+	// Content is returned in the requested format, so ask for the format the
+	// document was created in to avoid spurious JSON/YAML differences.
+	getDocumentInput := &ssm.GetDocumentInput{
+		DocumentFormat:  doc.DocumentFormat,
+		DocumentVersion: doc.DocumentVersion,
+		Name:            doc.Name,
+	}
+
+	getDocumentOutput, err := ssmconn.GetDocument(getDocumentInput)
+	if err != nil {
+		return fmt.Errorf("Error getting SSM document content: %s", err)
+	}
+
+	d.Set("content", getDocumentOutput.Content)
 	d.Set("created_date", doc.CreatedDate)
 	d.Set("default_version", doc.DefaultVersion)
 	d.Set("description", doc.Description)
 	d.Set("schema_version", doc.SchemaVersion)
-
-	if _, ok := d.GetOk("document_type"); ok {
-		d.Set("document_type", doc.DocumentType)
-	}
-
+	d.Set("document_type", doc.DocumentType)
 	d.Set("document_format", doc.DocumentFormat)
 	d.Set("document_version", doc.DocumentVersion)
 	d.Set("hash", doc.Hash)
@@ -292,7 +306,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingDocument); err != nil {
 			return fmt.Errorf("error setting SSM Document tags: %s", err)
 		}
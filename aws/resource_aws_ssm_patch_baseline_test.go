@@ -35,6 +35,11 @@ func TestAccAWSSSMPatchBaseline_basic(t *testing.T) {
 						"aws_ssm_patch_baseline.foo", "description", "Baseline containing all updates approved for production systems"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_patch_baseline.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSSSMPatchBaselineBasicConfigUpdated(name),
 				Check: resource.ComposeTestCheckFunc(
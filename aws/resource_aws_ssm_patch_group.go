@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -14,6 +15,12 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 		Create: resourceAwsSsmPatchGroupCreate,
 		Read:   resourceAwsSsmPatchGroupRead,
 		Delete: resourceAwsSsmPatchGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		MigrateState:  resourceAwsSsmPatchGroupMigrateState,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"baseline_id": {
@@ -43,28 +50,42 @@ func resourceAwsSsmPatchGroupCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	d.SetId(*resp.PatchGroup)
+	// A patch group can be registered with one baseline per operating system,
+	// so the patch group name alone does not identify the registration.
+	d.SetId(fmt.Sprintf("%s,%s", aws.StringValue(resp.PatchGroup), aws.StringValue(resp.BaselineId)))
 	return resourceAwsSsmPatchGroupRead(d, meta)
 }
 
 func resourceAwsSsmPatchGroupRead(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	params := &ssm.DescribePatchGroupsInput{}
-
-	resp, err := ssmconn.DescribePatchGroups(params)
+	patchGroup, baselineId, err := resourceAwsSsmPatchGroupParseId(d.Id())
 	if err != nil {
 		return err
 	}
 
+	params := &ssm.DescribePatchGroupsInput{}
+
 	found := false
-	for _, t := range resp.Mappings {
-		if *t.PatchGroup == d.Id() {
-			found = true
+	for {
+		resp, err := ssmconn.DescribePatchGroups(params)
+		if err != nil {
+			return err
+		}
 
-			d.Set("patch_group", t.PatchGroup)
-			d.Set("baseline_id", t.BaselineIdentity.BaselineId)
+		for _, t := range resp.Mappings {
+			if aws.StringValue(t.PatchGroup) == patchGroup && t.BaselineIdentity != nil && aws.StringValue(t.BaselineIdentity.BaselineId) == baselineId {
+				found = true
+
+				d.Set("patch_group", t.PatchGroup)
+				d.Set("baseline_id", t.BaselineIdentity.BaselineId)
+			}
+		}
+
+		if found || resp.NextToken == nil {
+			break
 		}
+		params.NextToken = resp.NextToken
 	}
 
 	if !found {
@@ -94,3 +115,12 @@ func resourceAwsSsmPatchGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+func resourceAwsSsmPatchGroupParseId(id string) (string, string, error) {
+	idx := strings.LastIndex(id, ",")
+	if idx <= 0 || idx == len(id)-1 {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected PATCH_GROUP,BASELINE_ID", id)
+	}
+
+	return id[:idx], id[idx+1:], nil
+}
@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceAwsSsmPatchGroupMigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AWS SSM Patch Group State v0; migrating to v1")
+		return migrateSsmPatchGroupStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func migrateSsmPatchGroupStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	newId := fmt.Sprintf("%s,%s", is.Attributes["patch_group"], is.Attributes["baseline_id"])
+	is.Attributes["id"] = newId
+	is.ID = newId
+
+	log.Printf("[DEBUG] Attributes after migration: %#v, new id: %s", is.Attributes, newId)
+
+	return is, nil
+
+}
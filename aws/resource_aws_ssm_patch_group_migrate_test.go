@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAWSSsmPatchGroupMigrateState(t *testing.T) {
+
+	cases := map[string]struct {
+		StateVersion int
+		ID           string
+		Attributes   map[string]string
+		Expected     string
+		Meta         interface{}
+	}{
+		"v0_1": {
+			StateVersion: 0,
+			ID:           "patch-group-name",
+			Attributes: map[string]string{
+				"baseline_id": "pb-0c10e65780EXAMPLE",
+				"patch_group": "patch-group-name",
+			},
+			Expected: "patch-group-name,pb-0c10e65780EXAMPLE",
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.ID,
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceAwsSsmPatchGroupMigrateState(
+			tc.StateVersion, is, tc.Meta)
+
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if is.ID != tc.Expected {
+			t.Fatalf("bad ssm patch group id: %s\n\n expected: %s", is.ID, tc.Expected)
+		}
+	}
+}
@@ -75,7 +75,7 @@ func resourceAwsTransferServer() *schema.Resource {
 
 func resourceAwsTransferServerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).transferconn
-	tags := tagsFromMapTransfer(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapTransfer(d.Get("tags_all").(map[string]interface{}))
 	createOpts := &transfer.CreateServerInput{}
 
 	if len(tags) != 0 {
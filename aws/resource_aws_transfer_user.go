@@ -88,7 +88,7 @@ func resourceAwsTransferUserCreate(d *schema.ResourceData, meta interface{}) err
 		createOpts.Policy = aws.String(attr.(string))
 	}
 
-	if attr, ok := d.GetOk("tags"); ok {
+	if attr, ok := d.GetOk("tags_all"); ok {
 		createOpts.Tags = tagsFromMapTransfer(attr.(map[string]interface{}))
 	}
 
@@ -16,6 +16,9 @@ func resourceAwsWafRegexMatchSet() *schema.Resource {
 		Read:   resourceAwsWafRegexMatchSetRead,
 		Update: resourceAwsWafRegexMatchSetUpdate,
 		Delete: resourceAwsWafRegexMatchSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -91,6 +91,11 @@ func TestAccAWSWafRuleGroup_basic(t *testing.T) {
 					testCheckResourceAttrWithIndexesAddr("aws_waf_rule_group.test", "activated_rule.%d.type", &idx, waf.WafRuleTypeRegular),
 				),
 			},
+			{
+				ResourceName:      "aws_waf_rule_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
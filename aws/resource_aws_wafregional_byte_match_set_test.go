@@ -55,6 +55,11 @@ func TestAccAWSWafRegionalByteMatchSet_basic(t *testing.T) {
 						"aws_wafregional_byte_match_set.byte_set", "byte_match_tuples.2081155357.text_transformation", "NONE"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_byte_match_set.byte_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -33,6 +33,11 @@ func TestAccAWSWafRegionalRateBasedRule_basic(t *testing.T) {
 						"aws_wafregional_rate_based_rule.wafrule", "metric_name", wafRuleName),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_rate_based_rule.wafrule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -49,6 +49,11 @@ func testAccAWSWafRegionalRegexPatternSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_wafregional_regex_pattern_set.test", "regex_pattern_strings.3351840846", "two"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_regex_pattern_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
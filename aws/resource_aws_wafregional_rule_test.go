@@ -33,6 +33,11 @@ func TestAccAWSWafRegionalRule_basic(t *testing.T) {
 						"aws_wafregional_rule.wafrule", "metric_name", wafRuleName),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_rule.wafrule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 		Create: resourceAwsWafRegionalWebAclAssociationCreate,
 		Read:   resourceAwsWafRegionalWebAclAssociationRead,
 		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsWafRegionalWebAclAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"web_acl_id": {
@@ -80,6 +83,11 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 	}
 
 	resp, err := conn.ListResourcesForWebACL(params)
+	if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+		log.Printf("[WARN] WAF Regional Web ACL (%s) not found, removing association (%s) from state", webAclId, d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -95,11 +103,24 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 	if !found {
 		log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("resource_arn", resourceArn)
+	d.Set("web_acl_id", webAclId)
+
 	return nil
 }
 
+func resourceAwsWafRegionalWebAclAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected WEB_ACL_ID:RESOURCE_ARN", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).wafregionalconn
 
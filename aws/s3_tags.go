@@ -2,41 +2,54 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
+		bucket := d.Get("bucket").(string)
+
+		// PutBucketTagging replaces the whole tag set of the bucket, so keep
+		// the tags not managed by Terraform, e.g. the ones matching the
+		// provider ignore_tags configuration.
+		raw, err := RetryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+			return getTagSetS3(conn, bucket)
+		})
+		if err != nil {
+			return err
+		}
+
+		tags := keyValueTagsS3(raw.([]*s3.Tag)).IgnoreAws()
+		tags = tags.Ignore(keyvaluetags.New(o)).Ignore(keyvaluetags.New(n))
+		tags = tags.Merge(keyvaluetags.New(n).IgnoreAws())
 
 		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
+		if len(tags) == 0 {
+			log.Printf("[DEBUG] Removing tags from %s", bucket)
 			_, err := RetryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
 				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
+					Bucket: aws.String(bucket),
 				})
 			})
 			if err != nil {
 				return err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+		} else {
+			log.Printf("[DEBUG] Setting tags: %v for %s", tags.Keys(), bucket)
 			req := &s3.PutBucketTaggingInput{
-				Bucket: aws.String(d.Get("bucket").(string)),
+				Bucket: aws.String(bucket),
 				Tagging: &s3.Tagging{
-					TagSet: create,
+					TagSet: tagsFromKeyValueTagsS3(tags),
 				},
 			}
 
@@ -52,57 +65,6 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapS3(create), remove
-}
-
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagsToMap turns the list of tags into a map.
-func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
 // return a slice of s3 tags associated with the given s3 bucket. Essentially
 // s3.GetBucketTagging, except returns an empty slice instead of an error when
 // there are no tags.
@@ -122,17 +84,49 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 	return response.TagSet, nil
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
+// diffTagsS3 takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
+	o, n := keyValueTagsS3(oldTags), keyValueTagsS3(newTags)
+
+	return tagsFromKeyValueTagsS3(o.Updated(n)), tagsFromKeyValueTagsS3(o.Removed(n))
+}
+
+// tagsFromMapS3 returns the tags for the given map of data.
+func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
+	return tagsFromKeyValueTagsS3(keyvaluetags.New(m).IgnoreAws())
+}
+
+// tagsToMapS3 turns the list of tags into a map.
+func tagsToMapS3(ts []*s3.Tag) map[string]string {
+	return keyValueTagsS3(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredS3 returns whether the tag is reserved and must be ignored.
 func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsS3 returns the tags engine representation of the tags.
+func keyValueTagsS3(ts []*s3.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
-	return false
+
+	return tags
+}
+
+// tagsFromKeyValueTagsS3 returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsS3(tags keyvaluetags.KeyValueTags) []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &s3.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
 }
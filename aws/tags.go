@@ -2,8 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -12,13 +10,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // tagsSchema returns the schema to use for tags.
-//
 func tagsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -34,117 +32,265 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
+// tagsUnreadKey is a placeholder tag key used to detect whether a resource's
+// Read function refreshed "tags" from the remote resource.
+const tagsUnreadKey = "\x00tags-unread"
+
+// resourceWithProviderTags applies the provider default_tags and ignore_tags
+// configuration to a resource whose "tags" argument is an optional map.
+//
+// The complete set of tags managed on the resource, the default tags
+// overridden by the resource's own tags, is tracked in the computed "tags_all"
+// attribute. This is the attribute the tagging helpers diff and apply, while
+// "tags" keeps only holding the tags set in the resource configuration so
+// that the default tags never show up as a difference. Tags matching the
+// ignore_tags configuration are kept out of both attributes, so they are
+// neither read into the state nor removed from the resource.
+func resourceWithProviderTags(r *schema.Resource) *schema.Resource {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional {
+		return r
+	}
+
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		ForceNew: s.ForceNew,
+	}
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.Sequence(setTagsAllDiff, r.CustomizeDiff)
+	} else {
+		r.CustomizeDiff = setTagsAllDiff
+	}
+
+	r.Create = applyProviderTagsFunc(r.Create)
+	r.Read = readProviderTagsFunc(r.Read)
+	if r.Update != nil {
+		r.Update = applyProviderTagsFunc(r.Update)
+	}
+
+	return r
+}
+
+// dataSourceWithProviderTags removes the tags matching the provider
+// ignore_tags configuration from the "tags" attribute of a data source.
+func dataSourceWithProviderTags(r *schema.Resource) *schema.Resource {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Computed {
+		return r
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		return d.Set("tags", tagsWithoutIgnored(meta, d.Get("tags").(map[string]interface{})))
+	}
+
+	return r
+}
+
+// setTagsAllDiff plans "tags_all" as the default tags merged with "tags".
+func setTagsAllDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	return diff.SetNew("tags_all", tagsMergeDefaults(meta, diff.Get("tags").(map[string]interface{})))
+}
+
+// applyProviderTagsFunc wraps a Create or Update function so that the
+// default and ignored tags read back from the remote resource are not stored
+// in "tags", unless the resource configuration sets them as well.
+func applyProviderTagsFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		configured := d.Get("tags").(map[string]interface{})
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		tags := tagsWithoutIgnored(meta, d.Get("tags").(map[string]interface{}))
+		if err := d.Set("tags", tagsWithoutDefaults(meta, tags, configured)); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+
+		return nil
+	}
+}
+
+// readProviderTagsFunc wraps a Read function so that "tags_all" holds all of
+// the tags read from the remote resource and "tags" only the ones that are
+// not inherited from the default tags. Ignored tags are dropped from both.
+func readProviderTagsFunc(f schema.ReadFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		configured := d.Get("tags").(map[string]interface{})
+
+		if err := d.Set("tags", map[string]interface{}{tagsUnreadKey: ""}); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		tags := d.Get("tags").(map[string]interface{})
+
+		// Some services cannot list the tags of a resource. Keep the tags
+		// last applied to it rather than dropping the default tags.
+		if _, ok := tags[tagsUnreadKey]; ok {
+			if err := d.Set("tags", configured); err != nil {
+				return fmt.Errorf("error setting tags: %s", err)
 			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
+
+			if v, ok := d.GetOk("tags_all"); !ok || len(v.(map[string]interface{})) == 0 {
+				if err := d.Set("tags_all", tagsMergeDefaults(meta, configured)); err != nil {
+					return fmt.Errorf("error setting tags_all: %s", err)
+				}
 			}
+
+			return nil
 		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
-			})
-			if err != nil {
-				return err
+
+		tags = tagsWithoutIgnored(meta, tags)
+
+		if err := d.Set("tags_all", tags); err != nil {
+			return fmt.Errorf("error setting tags_all: %s", err)
+		}
+
+		if err := d.Set("tags", tagsWithoutDefaults(meta, tags, configured)); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+
+		return nil
+	}
+}
+
+// tagsMergeDefaults returns the provider default tags merged with the given
+// resource tags. Resource tags take precedence over default tags. Tags
+// matching the provider ignore_tags configuration are left out.
+func tagsMergeDefaults(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	if client, ok := meta.(*AWSClient); ok {
+		for k, v := range client.defaultTags {
+			result[k] = v
+		}
+	}
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return tagsWithoutIgnored(meta, result)
+}
+
+// tagsWithoutDefaults returns the given tags minus the ones inherited from
+// the provider default tags. A tag is only considered inherited if it has the
+// default value and is not part of the configured resource tags.
+func tagsWithoutDefaults(meta interface{}, tags, configured map[string]interface{}) map[string]interface{} {
+	var defaultTags map[string]interface{}
+	if client, ok := meta.(*AWSClient); ok {
+		defaultTags = client.defaultTags
+	}
+
+	result := make(map[string]interface{})
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv == v {
+			if _, ok := configured[k]; !ok {
+				continue
 			}
 		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// tagsWithoutIgnored returns the given tags minus the ones matching the
+// provider ignore_tags configuration.
+func tagsWithoutIgnored(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := keyvaluetags.New(tags)
+
+	if client, ok := meta.(*AWSClient); ok {
+		result = result.Ignore(keyvaluetags.New(client.ignoreTagsKeys)).IgnorePrefixes(client.ignoreTagsKeyPrefixes)
+	}
+
+	return tagsMapToRaw(result.Map())
+}
+
+// tagsMergeIgnored returns the given tags with the remote tags matching the
+// provider ignore_tags configuration added back. Services whose API replaces
+// the whole tag set of a resource on update use it to not remove the tags
+// managed outside of Terraform.
+func tagsMergeIgnored(meta interface{}, tags map[string]interface{}, remoteTags keyvaluetags.KeyValueTags) keyvaluetags.KeyValueTags {
+	remoteTags = remoteTags.IgnoreAws()
+	ignored := remoteTags.Ignore(keyvaluetags.New(tagsWithoutIgnored(meta, tagsMapToRaw(remoteTags.Map()))))
+
+	return ignored.Merge(keyvaluetags.New(tags).IgnoreAws())
+}
+
+func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceELBv2(conn).Update(d.Id(), o, n)
 	}
 
 	return nil
 }
 
+// tagServiceELBv2 returns the tags engine adapter for ELBv2 resources.
+func tagServiceELBv2(conn *elbv2.ELBV2) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "ELBv2",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTags(&elbv2.AddTagsInput{
+				ResourceArns: []*string{aws.String(identifier)},
+				Tags:         tagsFromKeyValueTagsELBv2(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
+				ResourceArns: []*string{aws.String(identifier)},
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
 func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	if d.HasChange("volume_tags") {
-		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		o, n := d.GetChange("volume_tags")
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
 			return err
 		}
 
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: volumeIds,
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-					_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-						Resources: volumeIds,
-						Tags:      remove,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: volumeIds,
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-					_, err := conn.CreateTags(&ec2.CreateTagsInput{
-						Resources: volumeIds,
-						Tags:      create,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
+		s := tagServiceEC2(conn, 2*time.Minute)
+		for _, volumeId := range volumeIds {
+			if err := s.Update(aws.StringValue(volumeId), o, n); err != nil {
+				return err
 			}
 		}
 	}
@@ -155,231 +301,135 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-					_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-						Resources: []*string{aws.String(d.Id())},
-						Tags:      remove,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-					_, err := conn.CreateTags(&ec2.CreateTagsInput{
-						Resources: []*string{aws.String(d.Id())},
-						Tags:      create,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceEC2(conn, 5*time.Minute).Update(d.Id(), o, n)
 	}
 
 	return nil
 }
 
+// tagServiceEC2 returns the tags engine adapter for EC2 resources. Tagging
+// is retried while recently created resources are not found.
+func tagServiceEC2(conn *ec2.EC2, timeout time.Duration) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "EC2",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.CreateTags(&ec2.CreateTagsInput{
+				Resources: []*string{aws.String(identifier)},
+				Tags:      tagsFromKeyValueTagsEC2(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
+				Resources: []*string{aws.String(identifier)},
+				Tags:      tagsFromKeyValueTagsEC2(tags),
+			})
+
+			return err
+		},
+		IsRetryable: func(err error) bool {
+			awsErr, ok := err.(awserr.Error)
+			return ok && strings.Contains(awsErr.Code(), ".NotFound")
+		},
+		RetryTimeout: timeout,
+	}
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := keyValueTagsEC2(oldTags), keyValueTagsEC2(newTags)
 
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMap(create), remove
+	return tagsFromKeyValueTagsEC2(o.Updated(n)), tagsFromKeyValueTagsEC2(o.Removed(n))
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsEC2(keyvaluetags.New(m).IgnoreAws())
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyValueTagsEC2(ts).IgnoreAws().Map()
 }
 
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+// tagIgnored returns whether the tag is reserved and must be ignored.
+func tagIgnored(t *ec2.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
 
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+// keyValueTagsEC2 returns the tags engine representation of the tags.
+func keyValueTagsEC2(ts []*ec2.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return tagsFromMapELBv2(create), remove
+	return tags
 }
 
-// tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
+// tagsFromKeyValueTagsEC2 returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsEC2(tags keyvaluetags.KeyValueTags) []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ec2.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
 	return result
 }
 
+// diffElbV2Tags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
+	o, n := keyValueTagsELBv2(oldTags), keyValueTagsELBv2(newTags)
+
+	return tagsFromKeyValueTagsELBv2(o.Updated(n)), tagsFromKeyValueTagsELBv2(o.Removed(n))
+}
+
 // tagsFromMapELBv2 returns the tags for the given map of data.
 func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsELBv2(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+// tagsToMapELBv2 turns the list of tags into a map.
+func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
+	return keyValueTagsELBv2(ts).IgnoreAws().Map()
 }
 
-// and for ELBv2 as well
+// tagIgnoredELBv2 returns whether the tag is reserved and must be ignored.
 func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
 }
 
-// tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
-func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
-	result := make(map[string]string)
+// keyValueTagsELBv2 returns the tags engine representation of the tags.
+func keyValueTagsELBv2(ts []*elbv2.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
-	return result
+
+	return tags
 }
 
-// tagsFromMapDynamoDb returns the tags for a given map
-func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
-	result := make([]*dynamodb.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dynamodb.Tag{
+// tagsFromKeyValueTagsELBv2 returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsELBv2(tags keyvaluetags.KeyValueTags) []*elbv2.Tag {
+	var result []*elbv2.Tag
+	for _, k := range tags.Keys() {
+		result = append(result, &elbv2.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		result = append(result, t)
+			Value: tags[k],
+		})
 	}
+
 	return result
 }
 
@@ -388,73 +438,78 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	arn := d.Get("arn").(string)
-	oraw, nraw := d.GetChange("tags")
-	o := oraw.(map[string]interface{})
-	n := nraw.(map[string]interface{})
-	create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
-
-	// Set tags
-	if len(remove) > 0 {
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     remove,
+	o, n := d.GetChange("tags_all")
+
+	return tagServiceDynamoDb(conn).Update(d.Get("arn").(string), o, n)
+}
+
+// tagServiceDynamoDb returns the tags engine adapter for DynamoDB resources.
+// Tagging is retried while recently created resources are not found.
+func tagServiceDynamoDb(conn *dynamodb.DynamoDB) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "DynamoDB",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&dynamodb.TagResourceInput{
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsDynamoDb(tags),
 			})
-			if err != nil {
-				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if err != nil {
+
 			return err
-		}
-	}
-	if len(create) > 0 {
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.TagResource(&dynamodb.TagResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        create,
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
 			})
-			if err != nil {
-				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if err != nil {
+
 			return err
-		}
+		},
+		IsRetryable: func(err error) bool {
+			return isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "")
+		},
 	}
-
-	return nil
 }
 
-// diffTagsDynamoDb takes a local set of dynamodb tags and the ones found remotely
-// and returns the set of tags that must be created as a map, and returns a list of tag keys
-// that must be destroyed.
+// diffTagsDynamoDb takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tag keys that must
+// be destroyed.
 func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*string) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+	o, n := keyValueTagsDynamoDb(oldTags), keyValueTagsDynamoDb(newTags)
+
+	return tagsFromKeyValueTagsDynamoDb(o.Updated(n)), aws.StringSlice(o.Removed(n).Keys())
+}
+
+// tagsFromMapDynamoDb returns the tags for the given map of data.
+func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
+	return tagsFromKeyValueTagsDynamoDb(keyvaluetags.New(m).IgnoreAws())
+}
+
+// tagsToMapDynamoDb turns the list of tags into a map.
+func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
+	return keyValueTagsDynamoDb(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsDynamoDb returns the tags engine representation of the tags.
+func keyValueTagsDynamoDb(ts []*dynamodb.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	var remove []*string
-	for _, t := range oldTags {
-		// Verify the old tag is not a tag we're currently attempting to create
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t.Key)
-		}
+	return tags
+}
+
+// tagsFromKeyValueTagsDynamoDb returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDynamoDb(tags keyvaluetags.KeyValueTags) []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return tagsFromMapDynamoDb(create), remove
+
+	return result
 }
 
 // tagsMapToHash returns a stable hash value for a raw tags map.
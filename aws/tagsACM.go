@@ -1,87 +1,84 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// setTagsACM is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
 func setTagsACM(conn *acm.ACM, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsACM(tagsFromMapACM(o), tagsFromMapACM(n))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		// Set tags
-		if len(remove) > 0 {
-			input := acm.RemoveTagsFromCertificateInput{
-				CertificateArn: aws.String(d.Get("arn").(string)),
-				Tags:           remove,
-			}
-			log.Printf("[DEBUG] Removing ACM tags: %s", input)
-			_, err := conn.RemoveTagsFromCertificate(&input)
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			input := acm.AddTagsToCertificateInput{
-				CertificateArn: aws.String(d.Get("arn").(string)),
-				Tags:           create,
-			}
-			log.Printf("[DEBUG] Adding ACM tags: %s", input)
-			_, err := conn.AddTagsToCertificate(&input)
-			if err != nil {
-				return err
-			}
-		}
+		return tagServiceACM(conn).Update(d.Get("arn").(string), o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// tagServiceACM returns the tags engine adapter for ACM resources.
+func tagServiceACM(conn *acm.ACM) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "ACM",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTagsToCertificate(&acm.AddTagsToCertificateInput{
+				CertificateArn: aws.String(identifier),
+				Tags:           tagsFromKeyValueTagsACM(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTagsFromCertificate(&acm.RemoveTagsFromCertificateInput{
+				CertificateArn: aws.String(identifier),
+				Tags:           tagsFromKeyValueTagsACM(tags),
+			})
+
+			return err
+		},
+	}
+}
+
+// diffTagsACM takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsACM(oldTags, newTags []*acm.Tag) ([]*acm.Tag, []*acm.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*acm.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsACM(oldTags), keyValueTagsACM(newTags)
 
-	return tagsFromMapACM(create), remove
+	return tagsFromKeyValueTagsACM(o.Updated(n)), tagsFromKeyValueTagsACM(o.Removed(n))
 }
 
+// tagsFromMapACM returns the tags for the given map of data.
 func tagsFromMapACM(m map[string]interface{}) []*acm.Tag {
-	result := []*acm.Tag{}
-	for k, v := range m {
-		result = append(result, &acm.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return tagsFromKeyValueTagsACM(keyvaluetags.New(m).IgnoreAws())
 }
 
+// tagsToMapACM turns the list of tags into a map.
 func tagsToMapACM(ts []*acm.Tag) map[string]string {
-	result := map[string]string{}
+	return keyValueTagsACM(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsACM returns the tags engine representation of the tags.
+func keyValueTagsACM(ts []*acm.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsACM returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsACM(tags keyvaluetags.KeyValueTags) []*acm.Tag {
+	result := make([]*acm.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &acm.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
 	return result
@@ -3,47 +3,46 @@ package aws
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsACMPCA takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsACMPCA(oldTags, newTags []*acmpca.Tag) ([]*acmpca.Tag, []*acmpca.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*acmpca.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsACMPCA(oldTags), keyValueTagsACMPCA(newTags)
 
-	return tagsFromMapACMPCA(create), remove
+	return tagsFromKeyValueTagsACMPCA(o.Updated(n)), tagsFromKeyValueTagsACMPCA(o.Removed(n))
 }
 
+// tagsFromMapACMPCA returns the tags for the given map of data.
 func tagsFromMapACMPCA(m map[string]interface{}) []*acmpca.Tag {
-	result := []*acmpca.Tag{}
-	for k, v := range m {
-		result = append(result, &acmpca.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return tagsFromKeyValueTagsACMPCA(keyvaluetags.New(m).IgnoreAws())
 }
 
+// tagsToMapACMPCA turns the list of tags into a map.
 func tagsToMapACMPCA(ts []*acmpca.Tag) map[string]string {
-	result := map[string]string{}
+	return keyValueTagsACMPCA(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsACMPCA returns the tags engine representation of the tags.
+func keyValueTagsACMPCA(ts []*acmpca.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsACMPCA returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsACMPCA(tags keyvaluetags.KeyValueTags) []*acmpca.Tag {
+	result := make([]*acmpca.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &acmpca.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
 	return result
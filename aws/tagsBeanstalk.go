@@ -1,74 +1,60 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
+// ignoreTagsBeanstalk returns the tags without the ones managed by AWS and
+// Elastic Beanstalk itself.
+func ignoreTagsBeanstalk(tags keyvaluetags.KeyValueTags) keyvaluetags.KeyValueTags {
+	return tags.IgnoreAws().IgnorePrefixes([]string{"elasticbeanstalk:"}).Ignore(keyvaluetags.New([]string{"Name"}))
+}
+
+// diffTagsBeanstalk takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tag keys that must
 // be destroyed.
 func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*string) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*string
-	for _, t := range oldTags {
-		if _, ok := create[*t.Key]; !ok {
-			// Delete it!
-			remove = append(remove, t.Key)
-		}
-	}
+	o, n := keyValueTagsBeanstalk(oldTags), keyValueTagsBeanstalk(newTags)
 
-	return tagsFromMapBeanstalk(create), remove
+	return tagsFromKeyValueTagsBeanstalk(o.Updated(n)), aws.StringSlice(o.Removed(n).Keys())
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapBeanstalk returns the tags for the given map of data.
 func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
-	var result []*elasticbeanstalk.Tag
-	for k, v := range m {
-		t := &elasticbeanstalk.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredBeanstalk(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsBeanstalk(ignoreTagsBeanstalk(keyvaluetags.New(m)))
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapBeanstalk turns the list of tags into a map.
 func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
-	result := make(map[string]string)
+	return ignoreTagsBeanstalk(keyValueTagsBeanstalk(ts)).Map()
+}
+
+// tagIgnoredBeanstalk returns whether the tag is reserved and must be ignored.
+func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
+	return len(ignoreTagsBeanstalk(keyValueTagsBeanstalk([]*elasticbeanstalk.Tag{t}))) == 0
+}
+
+// keyValueTagsBeanstalk returns the tags engine representation of the tags.
+func keyValueTagsBeanstalk(ts []*elasticbeanstalk.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:", "^elasticbeanstalk:", "Name"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsBeanstalk returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsBeanstalk(tags keyvaluetags.KeyValueTags) []*elasticbeanstalk.Tag {
+	var result []*elasticbeanstalk.Tag
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticbeanstalk.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -1,98 +1,86 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
-				Resource: aws.String(arn),
-				TagKeys: &cloudfront.TagKeys{
-					Items: k,
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return tagServiceCloudFront(conn).Update(arn, o, n)
+	}
 
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
+	return nil
+}
+
+// tagServiceCloudFront returns the tags engine adapter for CloudFront resources.
+func tagServiceCloudFront(conn *cloudfront.CloudFront) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "CloudFront",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
 			_, err := conn.TagResource(&cloudfront.TagResourceInput{
-				Resource: aws.String(arn),
-				Tags: &cloudfront.Tags{
-					Items: create,
+				Resource: aws.String(identifier),
+				Tags:     tagsFromKeyValueTagsCloudFront(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
+				Resource: aws.String(identifier),
+				TagKeys: &cloudfront.TagKeys{
+					Items: aws.StringSlice(tags.Keys()),
 				},
 			})
-			if err != nil {
-				return err
-			}
-		}
 
+			return err
+		},
 	}
-
-	return nil
 }
-func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags.Items {
-		create[*t.Key] = *t.Value
-	}
 
-	// Build the list of what to remove
-	var remove []*cloudfront.Tag
-	for _, t := range oldTags.Items {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
+	o, n := keyValueTagsCloudFront(oldTags), keyValueTagsCloudFront(newTags)
 
-	createTags := tagsFromMapCloudFront(create)
-	return createTags.Items, remove
+	return tagsFromKeyValueTagsCloudFront(o.Updated(n)).Items, tagsFromKeyValueTagsCloudFront(o.Removed(n)).Items
 }
 
 func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
-	result := make([]*cloudfront.Tag, 0, len(m))
-	for k, v := range m {
-		result = append(result, &cloudfront.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+	return tagsFromKeyValueTagsCloudFront(keyvaluetags.New(m).IgnoreAws())
+}
+
+func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
+	return keyValueTagsCloudFront(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsCloudFront returns the tags engine representation of the tags.
+func keyValueTagsCloudFront(ts *cloudfront.Tags) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags)
+	if ts == nil {
+		return tags
 	}
 
-	tags := &cloudfront.Tags{
-		Items: result,
+	for _, t := range ts.Items {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
 	return tags
 }
 
-func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
-	result := make(map[string]string)
-
-	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
+// tagsFromKeyValueTagsCloudFront returns the tags, ordered by key.
+func tagsFromKeyValueTagsCloudFront(tags keyvaluetags.KeyValueTags) *cloudfront.Tags {
+	result := make([]*cloudfront.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &cloudfront.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
-	return result
+	return &cloudfront.Tags{
+		Items: result,
+	}
 }
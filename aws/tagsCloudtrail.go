@@ -1,113 +1,90 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsCloudtrail is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudtrail(tagsFromMapCloudtrail(o), tagsFromMapCloudtrail(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			input := cloudtrail.RemoveTagsInput{
-				ResourceId: aws.String(d.Get("arn").(string)),
-				TagsList:   remove,
-			}
-			log.Printf("[DEBUG] Removing CloudTrail tags: %s", input)
-			_, err := conn.RemoveTags(&input)
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			input := cloudtrail.AddTagsInput{
-				ResourceId: aws.String(d.Get("arn").(string)),
-				TagsList:   create,
-			}
-			log.Printf("[DEBUG] Adding CloudTrail tags: %s", input)
-			_, err := conn.AddTags(&input)
-			if err != nil {
-				return err
-			}
-		}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceCloudtrail(conn).Update(d.Get("arn").(string), o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// tagServiceCloudtrail returns the tags engine adapter for CloudTrail resources.
+func tagServiceCloudtrail(conn *cloudtrail.CloudTrail) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "CloudTrail",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTags(&cloudtrail.AddTagsInput{
+				ResourceId: aws.String(identifier),
+				TagsList:   tagsFromKeyValueTagsCloudtrail(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTags(&cloudtrail.RemoveTagsInput{
+				ResourceId: aws.String(identifier),
+				TagsList:   tagsFromKeyValueTagsCloudtrail(tags),
+			})
+
+			return err
+		},
+	}
+}
+
+// diffTagsCloudtrail takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsCloudtrail(oldTags, newTags []*cloudtrail.Tag) ([]*cloudtrail.Tag, []*cloudtrail.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*cloudtrail.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsCloudtrail(oldTags), keyValueTagsCloudtrail(newTags)
 
-	return tagsFromMapCloudtrail(create), remove
+	return tagsFromKeyValueTagsCloudtrail(o.Updated(n)), tagsFromKeyValueTagsCloudtrail(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapCloudtrail returns the tags for the given map of data.
 func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
-	var result []*cloudtrail.Tag
-	for k, v := range m {
-		t := &cloudtrail.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredCloudtrail(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsCloudtrail(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapCloudtrail turns the list of tags into a map.
 func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsCloudtrail(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredCloudtrail returns whether the tag is reserved and must be ignored.
+func tagIgnoredCloudtrail(t *cloudtrail.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsCloudtrail returns the tags engine representation of the tags.
+func keyValueTagsCloudtrail(ts []*cloudtrail.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredCloudtrail(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredCloudtrail(t *cloudtrail.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsCloudtrail returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsCloudtrail(tags keyvaluetags.KeyValueTags) []*cloudtrail.Tag {
+	result := make([]*cloudtrail.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &cloudtrail.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -3,24 +3,37 @@ package aws
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// tagsFromMapCodeBuild returns the tags for the given map of data.
 func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
-	result := []*codebuild.Tag{}
-	for k, v := range m {
-		result = append(result, &codebuild.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return tagsFromKeyValueTagsCodeBuild(keyvaluetags.New(m).IgnoreAws())
 }
 
+// tagsToMapCodeBuild turns the list of tags into a map.
 func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
-	result := map[string]string{}
+	return keyValueTagsCodeBuild(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsCodeBuild returns the tags engine representation of the tags.
+func keyValueTagsCodeBuild(ts []*codebuild.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsCodeBuild returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsCodeBuild(tags keyvaluetags.KeyValueTags) []*codebuild.Tag {
+	result := make([]*codebuild.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &codebuild.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
 	return result
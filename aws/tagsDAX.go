@@ -1,116 +1,90 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsDax is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDax(conn *dax.DAX, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDax(tagsFromMapDax(o), tagsFromMapDax(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&dax.UntagResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&dax.TagResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return tagServiceDax(conn).Update(arn, o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// tagServiceDax returns the tags engine adapter for DAX resources.
+func tagServiceDax(conn *dax.DAX) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "DAX",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&dax.TagResourceInput{
+				ResourceName: aws.String(identifier),
+				Tags:         tagsFromKeyValueTagsDax(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&dax.UntagResourceInput{
+				ResourceName: aws.String(identifier),
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// diffTagsDax takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsDax(oldTags, newTags []*dax.Tag) ([]*dax.Tag, []*dax.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := keyValueTagsDax(oldTags), keyValueTagsDax(newTags)
 
-	// Build the list of what to remove
-	var remove []*dax.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapDax(create), remove
+	return tagsFromKeyValueTagsDax(o.Updated(n)), tagsFromKeyValueTagsDax(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapDax returns the tags for the given map of data.
 func tagsFromMapDax(m map[string]interface{}) []*dax.Tag {
-	result := make([]*dax.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dax.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDax(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsDax(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapDax turns the list of tags into a map.
 func tagsToMapDax(ts []*dax.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsDax(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredDax returns whether the tag is reserved and must be ignored.
+func tagIgnoredDax(t *dax.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsDax returns the tags engine representation of the tags.
+func keyValueTagsDax(ts []*dax.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredDax(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredDax(t *dax.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsDax returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDax(tags keyvaluetags.KeyValueTags) []*dax.Tag {
+	result := make([]*dax.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dax.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -1,116 +1,90 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsDS is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDS(tagsFromMapDS(o), tagsFromMapDS(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.RemoveTagsFromResource(&directoryservice.RemoveTagsFromResourceInput{
-				ResourceId: aws.String(resourceId),
-				TagKeys:    k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
-			_, err := conn.AddTagsToResource(&directoryservice.AddTagsToResourceInput{
-				ResourceId: aws.String(resourceId),
-				Tags:       create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return tagServiceDS(conn).Update(resourceId, o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// tagServiceDS returns the tags engine adapter for Directory Service resources.
+func tagServiceDS(conn *directoryservice.DirectoryService) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Directory Service",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTagsToResource(&directoryservice.AddTagsToResourceInput{
+				ResourceId: aws.String(identifier),
+				Tags:       tagsFromKeyValueTagsDS(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&directoryservice.RemoveTagsFromResourceInput{
+				ResourceId: aws.String(identifier),
+				TagKeys:    aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// diffTagsDS takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsDS(oldTags, newTags []*directoryservice.Tag) ([]*directoryservice.Tag, []*directoryservice.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := keyValueTagsDS(oldTags), keyValueTagsDS(newTags)
 
-	// Build the list of what to remove
-	var remove []*directoryservice.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapDS(create), remove
+	return tagsFromKeyValueTagsDS(o.Updated(n)), tagsFromKeyValueTagsDS(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapDS returns the tags for the given map of data.
 func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
-	result := make([]*directoryservice.Tag, 0, len(m))
-	for k, v := range m {
-		t := &directoryservice.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDS(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsDS(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapDS turns the list of tags into a map.
 func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsDS(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredDS returns whether the tag is reserved and must be ignored.
+func tagIgnoredDS(t *directoryservice.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsDS returns the tags engine representation of the tags.
+func keyValueTagsDS(ts []*directoryservice.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredDS(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredDS(t *directoryservice.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsDS returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDS(tags keyvaluetags.KeyValueTags) []*directoryservice.Tag {
+	result := make([]*directoryservice.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &directoryservice.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -1,138 +1,118 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// getTags is a helper to get the tags for a resource. It expects the
-// tags field to be named "tags"
 func getTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string) error {
-	resp, err := conn.DescribeTags(&directconnect.DescribeTagsInput{
-		ResourceArns: aws.StringSlice([]string{arn}),
-	})
+	tags, err := tagServiceDX(conn).List(arn)
 	if err != nil {
 		return err
 	}
 
-	var tags []*directconnect.Tag
-	if len(resp.ResourceTags) == 1 && aws.StringValue(resp.ResourceTags[0].ResourceArn) == arn {
-		tags = resp.ResourceTags[0].Tags
-	}
-
-	if err := d.Set("tags", tagsToMapDX(tags)); err != nil {
+	if err := d.Set("tags", tags.Map()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsDX is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDX(conn *directconnect.DirectConnect, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDX(tagsFromMapDX(o), tagsFromMapDX(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&directconnect.UntagResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     k,
+		return tagServiceDX(conn).Update(arn, o, n)
+	}
+
+	return nil
+}
+
+// tagServiceDX returns the tags engine adapter for Direct Connect resources.
+func tagServiceDX(conn *directconnect.DirectConnect) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Direct Connect",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			resp, err := conn.DescribeTags(&directconnect.DescribeTagsInput{
+				ResourceArns: aws.StringSlice([]string{identifier}),
 			})
 			if err != nil {
-				return err
+				return nil, err
+			}
+
+			var tags []*directconnect.Tag
+			if len(resp.ResourceTags) == 1 && aws.StringValue(resp.ResourceTags[0].ResourceArn) == identifier {
+				tags = resp.ResourceTags[0].Tags
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+
+			return keyValueTagsDX(tags), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
 			_, err := conn.TagResource(&directconnect.TagResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        create,
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsDX(tags),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
 
-	return nil
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&directconnect.UntagResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsDX takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsDX(oldTags, newTags []*directconnect.Tag) ([]*directconnect.Tag, []*directconnect.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*directconnect.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsDX(oldTags), keyValueTagsDX(newTags)
 
-	return tagsFromMapDX(create), remove
+	return tagsFromKeyValueTagsDX(o.Updated(n)), tagsFromKeyValueTagsDX(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapDX returns the tags for the given map of data.
 func tagsFromMapDX(m map[string]interface{}) []*directconnect.Tag {
-	result := make([]*directconnect.Tag, 0, len(m))
-	for k, v := range m {
-		t := &directconnect.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDX(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsDX(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapDX turns the list of tags into a map.
 func tagsToMapDX(ts []*directconnect.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsDX(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredDX returns whether the tag is reserved and must be ignored.
+func tagIgnoredDX(t *directconnect.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsDX returns the tags engine representation of the tags.
+func keyValueTagsDX(ts []*directconnect.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredDX(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredDX(t *directconnect.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsDX returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDX(tags keyvaluetags.KeyValueTags) []*directconnect.Tag {
+	result := make([]*directconnect.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &directconnect.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// setTagsDataPipeline is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsDataPipeline(conn *datapipeline.DataPipeline, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceDataPipeline(conn).Update(d.Id(), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceDataPipeline returns the tags engine adapter for Data Pipeline resources.
+func tagServiceDataPipeline(conn *datapipeline.DataPipeline) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "DataPipeline",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			resp, err := conn.DescribePipelines(&datapipeline.DescribePipelinesInput{
+				PipelineIds: []*string{aws.String(identifier)},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if len(resp.PipelineDescriptionList) == 0 {
+				return nil, fmt.Errorf("Data Pipeline (%s) not found", identifier)
+			}
+
+			return keyValueTagsDataPipeline(resp.PipelineDescriptionList[0].Tags), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTags(&datapipeline.AddTagsInput{
+				PipelineId: aws.String(identifier),
+				Tags:       tagsFromKeyValueTagsDataPipeline(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTags(&datapipeline.RemoveTagsInput{
+				PipelineId: aws.String(identifier),
+				TagKeys:    aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// tagsFromMapDataPipeline returns the tags for the given map of data.
+func tagsFromMapDataPipeline(m map[string]interface{}) []*datapipeline.Tag {
+	return tagsFromKeyValueTagsDataPipeline(keyvaluetags.New(m).IgnoreAws())
+}
+
+// tagsToMapDataPipeline turns the list of tags into a map.
+func tagsToMapDataPipeline(ts []*datapipeline.Tag) map[string]string {
+	return keyValueTagsDataPipeline(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsDataPipeline returns the tags engine representation of the tags.
+func keyValueTagsDataPipeline(ts []*datapipeline.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsDataPipeline returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDataPipeline(tags keyvaluetags.KeyValueTags) []*datapipeline.Tag {
+	result := make([]*datapipeline.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &datapipeline.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
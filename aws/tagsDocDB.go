@@ -1,118 +1,109 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsDocDB is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsDocDB(conn *docdb.DocDB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDocDB(tagsFromMapDocDB(o), tagsFromMapDocDB(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.RemoveTagsFromResource(&docdb.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(d.Get("arn").(string)),
-				TagKeys:      k,
+		return tagServiceDocDB(conn).Update(d.Get("arn").(string), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceDocDB returns the tags engine adapter for DocDB resources.
+func tagServiceDocDB(conn *docdb.DocDB) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "DocDB",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			resp, err := conn.ListTagsForResource(&docdb.ListTagsForResourceInput{
+				ResourceName: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+
+			return keyValueTagsDocDB(resp.TagList), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
 			_, err := conn.AddTagsToResource(&docdb.AddTagsToResourceInput{
-				ResourceName: aws.String(d.Get("arn").(string)),
-				Tags:         create,
+				ResourceName: aws.String(identifier),
+				Tags:         tagsFromKeyValueTagsDocDB(tags),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
 
-	return nil
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&docdb.RemoveTagsFromResourceInput{
+				ResourceName: aws.String(identifier),
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsDocDB takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsDocDB(oldTags, newTags []*docdb.Tag) ([]*docdb.Tag, []*docdb.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := keyValueTagsDocDB(oldTags), keyValueTagsDocDB(newTags)
 
-	// Build the list of what to remove
-	var remove []*docdb.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMapDocDB(create), remove
+	return tagsFromKeyValueTagsDocDB(o.Updated(n)), tagsFromKeyValueTagsDocDB(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapDocDB returns the tags for the given map of data.
 func tagsFromMapDocDB(m map[string]interface{}) []*docdb.Tag {
-	result := make([]*docdb.Tag, 0, len(m))
-	for k, v := range m {
-		t := &docdb.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredDocDB(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsDocDB(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapDocDB turns the list of tags into a map.
 func tagsToMapDocDB(ts []*docdb.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsDocDB(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredDocDB returns whether the tag is reserved and must be ignored.
+func tagIgnoredDocDB(t *docdb.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsDocDB returns the tags engine representation of the tags.
+func keyValueTagsDocDB(ts []*docdb.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredDocDB(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsDocDB returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDocDB(tags keyvaluetags.KeyValueTags) []*docdb.Tag {
+	result := make([]*docdb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &docdb.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
 
 	return result
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredDocDB(t *docdb.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		if r, _ := regexp.MatchString(v, aws.StringValue(t.Key)); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
+func saveTagsDocDB(conn *docdb.DocDB, d *schema.ResourceData, arn string) error {
+	tags, err := tagServiceDocDB(conn).List(arn)
+	if err != nil {
+		return err
 	}
-	return false
+
+	return d.Set("tags", tags.Map())
 }
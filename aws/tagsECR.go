@@ -1,135 +1,113 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// getTags is a helper to get the tags for a resource. It expects the
-// tags field to be named "tags" and the ARN field to be named "arn".
 func getTagsECR(conn *ecr.ECR, d *schema.ResourceData) error {
-	resp, err := conn.ListTagsForResource(&ecr.ListTagsForResourceInput{
-		ResourceArn: aws.String(d.Get("arn").(string)),
-	})
+	tags, err := tagServiceECR(conn).List(d.Get("arn").(string))
 	if err != nil {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapECR(resp.Tags)); err != nil {
+	if err := d.Set("tags", tags.Map()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags" and the ARN field to be named "arn".
+// setTagsECR is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
 func setTagsECR(conn *ecr.ECR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsECR(tagsFromMapECR(o), tagsFromMapECR(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&ecr.UntagResourceInput{
-				ResourceArn: aws.String(d.Get("arn").(string)),
-				TagKeys:     k,
+		return tagServiceECR(conn).Update(d.Get("arn").(string), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceECR returns the tags engine adapter for ECR resources.
+func tagServiceECR(conn *ecr.ECR) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "ECR",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			resp, err := conn.ListTagsForResource(&ecr.ListTagsForResourceInput{
+				ResourceArn: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+
+			return keyValueTagsECR(resp.Tags), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
 			_, err := conn.TagResource(&ecr.TagResourceInput{
-				ResourceArn: aws.String(d.Get("arn").(string)),
-				Tags:        create,
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsECR(tags),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
 
-	return nil
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&ecr.UntagResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsECR takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsECR(oldTags, newTags []*ecr.Tag) ([]*ecr.Tag, []*ecr.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*ecr.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
+	o, n := keyValueTagsECR(oldTags), keyValueTagsECR(newTags)
 
-	return tagsFromMapECR(create), remove
+	return tagsFromKeyValueTagsECR(o.Updated(n)), tagsFromKeyValueTagsECR(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapECR returns the tags for the given map of data.
 func tagsFromMapECR(m map[string]interface{}) []*ecr.Tag {
-	result := make([]*ecr.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ecr.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredECR(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsECR(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapECR turns the list of tags into a map.
 func tagsToMapECR(ts []*ecr.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsECR(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredECR returns whether the tag is reserved and must be ignored.
+func tagIgnoredECR(t *ecr.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsECR returns the tags engine representation of the tags.
+func keyValueTagsECR(ts []*ecr.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredECR(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredECR(t *ecr.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsECR returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsECR(tags keyvaluetags.KeyValueTags) []*ecr.Tag {
+	result := make([]*ecr.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ecr.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
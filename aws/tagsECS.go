@@ -1,78 +1,54 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsECS takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsECS(oldTags, newTags []*ecs.Tag) ([]*ecs.Tag, []*ecs.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := keyValueTagsECS(oldTags), keyValueTagsECS(newTags)
 
-	// Build the list of what to remove
-	var remove []*ecs.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
+	return tagsFromKeyValueTagsECS(o.Updated(n)), tagsFromKeyValueTagsECS(o.Removed(n))
+}
 
-	return tagsFromMapECS(create), remove
+// tagsFromMapECS returns the tags for the given map of data.
+func tagsFromMapECS(m map[string]interface{}) []*ecs.Tag {
+	return tagsFromKeyValueTagsECS(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapECS(tagMap map[string]interface{}) []*ecs.Tag {
-	tags := make([]*ecs.Tag, 0, len(tagMap))
-	for tagKey, tagValueRaw := range tagMap {
-		tag := &ecs.Tag{
-			Key:   aws.String(tagKey),
-			Value: aws.String(tagValueRaw.(string)),
-		}
-		if !tagIgnoredECS(tag) {
-			tags = append(tags, tag)
-		}
-	}
+// tagsToMapECS turns the list of tags into a map.
+func tagsToMapECS(ts []*ecs.Tag) map[string]string {
+	return keyValueTagsECS(ts).IgnoreAws().Map()
+}
 
-	return tags
+// tagIgnoredECS returns whether the tag is reserved and must be ignored.
+func tagIgnoredECS(t *ecs.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapECS(tags []*ecs.Tag) map[string]string {
-	tagMap := make(map[string]string)
-	for _, tag := range tags {
-		if !tagIgnoredECS(tag) {
-			tagMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
-		}
+// keyValueTagsECS returns the tags engine representation of the tags.
+func keyValueTagsECS(ts []*ecs.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return tagMap
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredECS(t *ecs.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		r, _ := regexp.MatchString(v, aws.StringValue(t.Key))
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
+// tagsFromKeyValueTagsECS returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsECS(tags keyvaluetags.KeyValueTags) []*ecs.Tag {
+	result := make([]*ecs.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ecs.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -0,0 +1,99 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// setTagsFSx is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsFSx(conn *fsx.FSx, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceFSx(conn).Update(d.Get("arn").(string), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceFSx returns the tags engine adapter for FSx resources.
+func tagServiceFSx(conn *fsx.FSx) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "FSx",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			tags := keyvaluetags.New(nil)
+			input := &fsx.ListTagsForResourceInput{
+				ResourceARN: aws.String(identifier),
+			}
+
+			for {
+				resp, err := conn.ListTagsForResource(input)
+				if err != nil {
+					return nil, err
+				}
+
+				tags = tags.Merge(keyValueTagsFSx(resp.Tags))
+
+				if aws.StringValue(resp.NextToken) == "" {
+					break
+				}
+
+				input.NextToken = resp.NextToken
+			}
+
+			return tags, nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&fsx.TagResourceInput{
+				ResourceARN: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsFSx(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&fsx.UntagResourceInput{
+				ResourceARN: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// tagsFromMapFSx returns the tags for the given map of data.
+func tagsFromMapFSx(m map[string]interface{}) []*fsx.Tag {
+	return tagsFromKeyValueTagsFSx(keyvaluetags.New(m).IgnoreAws())
+}
+
+// tagsToMapFSx turns the list of tags into a map.
+func tagsToMapFSx(ts []*fsx.Tag) map[string]string {
+	return keyValueTagsFSx(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsFSx returns the tags engine representation of the tags.
+func keyValueTagsFSx(ts []*fsx.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsFSx returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsFSx(tags keyvaluetags.KeyValueTags) []*fsx.Tag {
+	result := make([]*fsx.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &fsx.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
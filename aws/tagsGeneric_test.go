@@ -40,9 +40,21 @@ func TestDiffGenericTags(t *testing.T) {
 			Create: map[string]string{
 				"foo": "baz",
 			},
-			Remove: map[string]string{
-				"foo": "bar",
+			Remove: map[string]string{},
+		},
+
+		// Unchanged
+		{
+			Old: map[string]interface{}{
+				"foo":   "bar",
+				"hello": "world",
+			},
+			New: map[string]interface{}{
+				"foo":   "bar",
+				"hello": "world",
 			},
+			Create: map[string]string{},
+			Remove: map[string]string{},
 		},
 	}
 
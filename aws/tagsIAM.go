@@ -1,79 +1,56 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsIAM takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsIAM(oldTags, newTags []*iam.Tag) ([]*iam.Tag, []*iam.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := keyValueTagsIAM(oldTags), keyValueTagsIAM(newTags)
 
-	// Build the list of what to remove
-	var remove []*iam.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		} else if ok {
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMapIAM(create), remove
+	return tagsFromKeyValueTagsIAM(o.Updated(n)), tagsFromKeyValueTagsIAM(o.Removed(n))
 }
 
-// tagsFromMapIAM returns the tags for the given map of data for IAM.
+// tagsFromMapIAM returns the tags for the given map of data.
 func tagsFromMapIAM(m map[string]interface{}) []*iam.Tag {
-	result := make([]*iam.Tag, 0, len(m))
-	for k, v := range m {
-		t := &iam.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredIAM(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsIAM(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMapIAM turns the list of IAM tags into a map.
+// tagsToMapIAM turns the list of tags into a map.
 func tagsToMapIAM(ts []*iam.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsIAM(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredIAM returns whether the tag is reserved and must be ignored.
+func tagIgnoredIAM(t *iam.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsIAM returns the tags engine representation of the tags.
+func keyValueTagsIAM(ts []*iam.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredIAM(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredIAM(t *iam.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsIAM returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsIAM(tags keyvaluetags.KeyValueTags) []*iam.Tag {
+	result := make([]*iam.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &iam.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
 
 // tagKeysIam returns the keys for the list of IAM tags
@@ -1,149 +1,128 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// getTags is a helper to get the tags for a resource. It expects the
-// tags field to be named "tags"
 func getTagsKinesisFirehose(conn *firehose.Firehose, d *schema.ResourceData, sn string) error {
-	tags := make([]*firehose.Tag, 0)
-	var exclusiveStartTagKey string
-	for {
-		req := &firehose.ListTagsForDeliveryStreamInput{
-			DeliveryStreamName: aws.String(sn),
-		}
-		if exclusiveStartTagKey != "" {
-			req.ExclusiveStartTagKey = aws.String(exclusiveStartTagKey)
-		}
-
-		resp, err := conn.ListTagsForDeliveryStream(req)
-		if err != nil {
-			return err
-		}
-
-		tags = append(tags, resp.Tags...)
-
-		// If HasMoreTags is true in the response, more tags are available.
-		// To list the remaining tags, set ExclusiveStartTagKey to the key
-		// of the last tag returned and call ListTagsForDeliveryStream again.
-		if !aws.BoolValue(resp.HasMoreTags) {
-			break
-		}
-		exclusiveStartTagKey = aws.StringValue(tags[len(tags)-1].Key)
+	tags, err := tagServiceKinesisFirehose(conn).List(sn)
+	if err != nil {
+		return err
 	}
 
-	err := d.Set("tags", tagsToMapKinesisFirehose(tags))
-	return err
+	return d.Set("tags", tags.Map())
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsKinesisFirehose is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsKinesisFirehose(conn *firehose.Firehose, d *schema.ResourceData, sn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKinesisFirehose(tagsFromMapKinesisFirehose(o), tagsFromMapKinesisFirehose(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagDeliveryStream(&firehose.UntagDeliveryStreamInput{
-				DeliveryStreamName: aws.String(sn),
-				TagKeys:            k,
-			})
-			if err != nil {
-				return err
+		return tagServiceKinesisFirehose(conn).Update(sn, o, n)
+	}
+
+	return nil
+}
+
+// tagServiceKinesisFirehose returns the tags engine adapter for Kinesis Firehose resources.
+func tagServiceKinesisFirehose(conn *firehose.Firehose) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Kinesis Firehose",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			tags := make([]*firehose.Tag, 0)
+			var exclusiveStartTagKey string
+			for {
+				req := &firehose.ListTagsForDeliveryStreamInput{
+					DeliveryStreamName: aws.String(identifier),
+				}
+				if exclusiveStartTagKey != "" {
+					req.ExclusiveStartTagKey = aws.String(exclusiveStartTagKey)
+				}
+
+				resp, err := conn.ListTagsForDeliveryStream(req)
+				if err != nil {
+					return nil, err
+				}
+
+				tags = append(tags, resp.Tags...)
+
+				// If HasMoreTags is true in the response, more tags are available.
+				// To list the remaining tags, set ExclusiveStartTagKey to the key
+				// of the last tag returned and call ListTagsForDeliveryStream again.
+				if !aws.BoolValue(resp.HasMoreTags) {
+					break
+				}
+				exclusiveStartTagKey = aws.StringValue(tags[len(tags)-1].Key)
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
+
+			return keyValueTagsKinesisFirehose(tags), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
 			_, err := conn.TagDeliveryStream(&firehose.TagDeliveryStreamInput{
-				DeliveryStreamName: aws.String(sn),
-				Tags:               create,
+				DeliveryStreamName: aws.String(identifier),
+				Tags:               tagsFromKeyValueTagsKinesisFirehose(tags),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
 
-	return nil
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagDeliveryStream(&firehose.UntagDeliveryStreamInput{
+				DeliveryStreamName: aws.String(identifier),
+				TagKeys:            aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsKinesisFirehose takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsKinesisFirehose(oldTags, newTags []*firehose.Tag) ([]*firehose.Tag, []*firehose.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*firehose.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsKinesisFirehose(oldTags), keyValueTagsKinesisFirehose(newTags)
 
-	return tagsFromMapKinesisFirehose(create), remove
+	return tagsFromKeyValueTagsKinesisFirehose(o.Updated(n)), tagsFromKeyValueTagsKinesisFirehose(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapKinesisFirehose returns the tags for the given map of data.
 func tagsFromMapKinesisFirehose(m map[string]interface{}) []*firehose.Tag {
-	result := make([]*firehose.Tag, 0, len(m))
-	for k, v := range m {
-		t := &firehose.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredKinesisFirehose(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsKinesisFirehose(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapKinesisFirehose turns the list of tags into a map.
 func tagsToMapKinesisFirehose(ts []*firehose.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsKinesisFirehose(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredKinesisFirehose returns whether the tag is reserved and must be ignored.
+func tagIgnoredKinesisFirehose(t *firehose.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsKinesisFirehose returns the tags engine representation of the tags.
+func keyValueTagsKinesisFirehose(ts []*firehose.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredKinesisFirehose(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredKinesisFirehose(t *firehose.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsKinesisFirehose returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsKinesisFirehose(tags keyvaluetags.KeyValueTags) []*firehose.Tag {
+	result := make([]*firehose.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &firehose.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
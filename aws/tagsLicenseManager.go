@@ -1,115 +1,90 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsLicenseManager is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsLicenseManager(conn *licensemanager.LicenseManager, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsLicenseManager(tagsFromMapLicenseManager(o), tagsFromMapLicenseManager(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&licensemanager.UntagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				TagKeys:     k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&licensemanager.TagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				Tags:        create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return tagServiceLicenseManager(conn).Update(d.Id(), o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// tagServiceLicenseManager returns the tags engine adapter for License Manager resources.
+func tagServiceLicenseManager(conn *licensemanager.LicenseManager) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "License Manager",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&licensemanager.TagResourceInput{
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsLicenseManager(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&licensemanager.UntagResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// diffTagsLicenseManager takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsLicenseManager(oldTags, newTags []*licensemanager.Tag) ([]*licensemanager.Tag, []*licensemanager.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := keyValueTagsLicenseManager(oldTags), keyValueTagsLicenseManager(newTags)
 
-	// Build the list of what to remove
-	var remove []*licensemanager.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapLicenseManager(create), remove
+	return tagsFromKeyValueTagsLicenseManager(o.Updated(n)), tagsFromKeyValueTagsLicenseManager(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapLicenseManager returns the tags for the given map of data.
 func tagsFromMapLicenseManager(m map[string]interface{}) []*licensemanager.Tag {
-	result := make([]*licensemanager.Tag, 0, len(m))
-	for k, v := range m {
-		t := &licensemanager.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredLicenseManager(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsLicenseManager(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapLicenseManager turns the list of tags into a map.
 func tagsToMapLicenseManager(ts []*licensemanager.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsLicenseManager(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredLicenseManager returns whether the tag is reserved and must be ignored.
+func tagIgnoredLicenseManager(t *licensemanager.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsLicenseManager returns the tags engine representation of the tags.
+func keyValueTagsLicenseManager(ts []*licensemanager.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredLicenseManager(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredLicenseManager(t *licensemanager.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsLicenseManager returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsLicenseManager(tags keyvaluetags.KeyValueTags) []*licensemanager.Tag {
+	result := make([]*licensemanager.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &licensemanager.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// setTagsMediaConvert is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsMediaConvert(conn *mediaconvert.MediaConvert, d *schema.ResourceData, arn string) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceMediaConvert(conn).Update(arn, o, n)
+	}
+
+	return nil
+}
+
+// tagServiceMediaConvert returns the tags engine adapter for MediaConvert resources.
+func tagServiceMediaConvert(conn *mediaconvert.MediaConvert) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "MediaConvert",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			resp, err := conn.ListTagsForResource(&mediaconvert.ListTagsForResourceInput{
+				Arn: aws.String(identifier),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.ResourceTags == nil {
+				return keyvaluetags.KeyValueTags{}, nil
+			}
+
+			return keyvaluetags.New(resp.ResourceTags.Tags), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&mediaconvert.TagResourceInput{
+				Arn:  aws.String(identifier),
+				Tags: tags,
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&mediaconvert.UntagResourceInput{
+				Arn:     aws.String(identifier),
+				TagKeys: aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
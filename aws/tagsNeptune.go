@@ -1,134 +1,109 @@
 package aws
 
 import (
-	"fmt"
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// setTagsNeptune is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsNeptune(conn *neptune.Neptune, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsNeptune(tagsFromMapNeptune(o), tagsFromMapNeptune(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.RemoveTagsFromResource(&neptune.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
+		return tagServiceNeptune(conn).Update(arn, o, n)
+	}
+
+	return nil
+}
+
+// tagServiceNeptune returns the tags engine adapter for Neptune resources.
+func tagServiceNeptune(conn *neptune.Neptune) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Neptune",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			resp, err := conn.ListTagsForResource(&neptune.ListTagsForResourceInput{
+				ResourceName: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
+
+			return keyValueTagsNeptune(resp.TagList), nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
 			_, err := conn.AddTagsToResource(&neptune.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
+				ResourceName: aws.String(identifier),
+				Tags:         tagsFromKeyValueTagsNeptune(tags),
 			})
-			if err != nil {
-				return err
-			}
-		}
-	}
 
-	return nil
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&neptune.RemoveTagsFromResourceInput{
+				ResourceName: aws.String(identifier),
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsNeptune takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsNeptune(oldTags, newTags []*neptune.Tag) ([]*neptune.Tag, []*neptune.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := keyValueTagsNeptune(oldTags), keyValueTagsNeptune(newTags)
 
-	// Build the list of what to remove
-	var remove []*neptune.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapNeptune(create), remove
+	return tagsFromKeyValueTagsNeptune(o.Updated(n)), tagsFromKeyValueTagsNeptune(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapNeptune returns the tags for the given map of data.
 func tagsFromMapNeptune(m map[string]interface{}) []*neptune.Tag {
-	result := make([]*neptune.Tag, 0, len(m))
-	for k, v := range m {
-		t := &neptune.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredNeptune(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsNeptune(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapNeptune turns the list of tags into a map.
 func tagsToMapNeptune(ts []*neptune.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsNeptune(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredNeptune returns whether the tag is reserved and must be ignored.
+func tagIgnoredNeptune(t *neptune.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsNeptune returns the tags engine representation of the tags.
+func keyValueTagsNeptune(ts []*neptune.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredNeptune(t) {
-			result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredNeptune(t *neptune.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		r, _ := regexp.MatchString(v, aws.StringValue(t.Key))
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
+// tagsFromKeyValueTagsNeptune returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsNeptune(tags keyvaluetags.KeyValueTags) []*neptune.Tag {
+	result := make([]*neptune.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &neptune.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
 
 func saveTagsNeptune(conn *neptune.Neptune, d *schema.ResourceData, arn string) error {
-	resp, err := conn.ListTagsForResource(&neptune.ListTagsForResourceInput{
-		ResourceName: aws.String(arn),
-	})
-
+	tags, err := tagServiceNeptune(conn).List(arn)
 	if err != nil {
-		return fmt.Errorf("Error retreiving tags for ARN: %s", arn)
-	}
-
-	var dt []*neptune.Tag
-	if len(resp.TagList) > 0 {
-		dt = resp.TagList
+		return err
 	}
 
-	return d.Set("tags", tagsToMapNeptune(dt))
+	return d.Set("tags", tags.Map())
 }
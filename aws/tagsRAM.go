@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+// setTagsRAM is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsRAM(conn *ram.RAM, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceRAM(conn).Update(d.Id(), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceRAM returns the tags engine adapter for RAM resource shares.
+func tagServiceRAM(conn *ram.RAM) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "RAM",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&ram.TagResourceInput{
+				ResourceShareArn: aws.String(identifier),
+				Tags:             tagsFromKeyValueTagsRAM(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&ram.UntagResourceInput{
+				ResourceShareArn: aws.String(identifier),
+				TagKeys:          aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// tagsFromMapRAM returns the tags for the given map of data.
+func tagsFromMapRAM(m map[string]interface{}) []*ram.Tag {
+	return tagsFromKeyValueTagsRAM(keyvaluetags.New(m).IgnoreAws())
+}
+
+// tagsToMapRAM turns the list of tags into a map.
+func tagsToMapRAM(ts []*ram.Tag) map[string]string {
+	return keyValueTagsRAM(ts).IgnoreAws().Map()
+}
+
+// keyValueTagsRAM returns the tags engine representation of the tags.
+func keyValueTagsRAM(ts []*ram.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsRAM returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsRAM(tags keyvaluetags.KeyValueTags) []*ram.Tag {
+	result := make([]*ram.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ram.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
@@ -1,109 +1,90 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// setTagsRedshift is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
 func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRedshift(tagsFromMapRedshift(o), tagsFromMapRedshift(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			k := make([]*string, len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.DeleteTags(&redshift.DeleteTagsInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      k,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.CreateTags(&redshift.CreateTagsInput{
-				ResourceName: aws.String(arn),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+		return tagServiceRedshift(conn).Update(arn, o, n)
 	}
 
 	return nil
 }
 
-func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*redshift.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+// tagServiceRedshift returns the tags engine adapter for Redshift resources.
+func tagServiceRedshift(conn *redshift.Redshift) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Redshift",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.CreateTags(&redshift.CreateTagsInput{
+				ResourceName: aws.String(identifier),
+				Tags:         tagsFromKeyValueTagsRedshift(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.DeleteTags(&redshift.DeleteTagsInput{
+				ResourceName: aws.String(identifier),
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
 
-	// Build the list of what to remove
-	var remove []*redshift.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+			return err
+		},
 	}
+}
+
+// diffTagsRedshift takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
+func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*redshift.Tag) {
+	o, n := keyValueTagsRedshift(oldTags), keyValueTagsRedshift(newTags)
 
-	return tagsFromMapRedshift(create), remove
+	return tagsFromKeyValueTagsRedshift(o.Updated(n)), tagsFromKeyValueTagsRedshift(o.Removed(n))
 }
 
+// tagsFromMapRedshift returns the tags for the given map of data.
 func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
-	result := make([]*redshift.Tag, 0, len(m))
-	for k, v := range m {
-		t := &redshift.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRedshift(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsRedshift(keyvaluetags.New(m).IgnoreAws())
 }
 
+// tagsToMapRedshift turns the list of tags into a map.
 func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsRedshift(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredRedshift returns whether the tag is reserved and must be ignored.
+func tagIgnoredRedshift(t *redshift.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsRedshift returns the tags engine representation of the tags.
+func keyValueTagsRedshift(ts []*redshift.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredRedshift(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredRedshift(t *redshift.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsRedshift returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsRedshift(tags keyvaluetags.KeyValueTags) []*redshift.Tag {
+	result := make([]*redshift.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &redshift.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
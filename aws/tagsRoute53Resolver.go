@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func getTagsRoute53Resolver(conn *route53resolver.Route53Resolver, d *schema.ResourceData) error {
+	tags, err := tagServiceRoute53Resolver(conn).List(d.Get("arn").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setTagsRoute53Resolver is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsRoute53Resolver(conn *route53resolver.Route53Resolver, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceRoute53Resolver(conn).Update(d.Get("arn").(string), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceRoute53Resolver returns the tags engine adapter for Route 53 Resolver resources.
+func tagServiceRoute53Resolver(conn *route53resolver.Route53Resolver) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Route 53 Resolver",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			tags := keyvaluetags.New(nil)
+			input := &route53resolver.ListTagsForResourceInput{
+				ResourceArn: aws.String(identifier),
+			}
+
+			for {
+				resp, err := conn.ListTagsForResource(input)
+				if err != nil {
+					return nil, err
+				}
+
+				tags = tags.Merge(keyValueTagsRoute53Resolver(resp.Tags))
+
+				if aws.StringValue(resp.NextToken) == "" {
+					break
+				}
+
+				input.NextToken = resp.NextToken
+			}
+
+			return tags, nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.TagResource(&route53resolver.TagResourceInput{
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsRoute53Resolver(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.UntagResource(&route53resolver.UntagResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// tagsFromMapRoute53Resolver returns the tags for the given map of data.
+func tagsFromMapRoute53Resolver(m map[string]interface{}) []*route53resolver.Tag {
+	return tagsFromKeyValueTagsRoute53Resolver(keyvaluetags.New(m).IgnoreAws())
+}
+
+// keyValueTagsRoute53Resolver returns the tags engine representation of the tags.
+func keyValueTagsRoute53Resolver(ts []*route53resolver.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsRoute53Resolver returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsRoute53Resolver(tags keyvaluetags.KeyValueTags) []*route53resolver.Tag {
+	result := make([]*route53resolver.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &route53resolver.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
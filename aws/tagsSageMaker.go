@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func getTagsSageMaker(conn *sagemaker.SageMaker, d *schema.ResourceData) error {
+	tags, err := tagServiceSageMaker(conn).List(d.Get("arn").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setTagsSageMaker is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func setTagsSageMaker(conn *sagemaker.SageMaker, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceSageMaker(conn).Update(d.Get("arn").(string), o, n)
+	}
+
+	return nil
+}
+
+// tagServiceSageMaker returns the tags engine adapter for SageMaker resources.
+func tagServiceSageMaker(conn *sagemaker.SageMaker) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "SageMaker",
+		ListTags: func(identifier string) (keyvaluetags.KeyValueTags, error) {
+			tags := keyvaluetags.New(nil)
+			input := &sagemaker.ListTagsInput{
+				ResourceArn: aws.String(identifier),
+			}
+
+			for {
+				resp, err := conn.ListTags(input)
+				if err != nil {
+					return nil, err
+				}
+
+				tags = tags.Merge(keyValueTagsSageMaker(resp.Tags))
+
+				if aws.StringValue(resp.NextToken) == "" {
+					break
+				}
+
+				input.NextToken = resp.NextToken
+			}
+
+			return tags, nil
+		},
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTags(&sagemaker.AddTagsInput{
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsSageMaker(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.DeleteTags(&sagemaker.DeleteTagsInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+	}
+}
+
+// tagsFromMapSageMaker returns the tags for the given map of data.
+func tagsFromMapSageMaker(m map[string]interface{}) []*sagemaker.Tag {
+	return tagsFromKeyValueTagsSageMaker(keyvaluetags.New(m).IgnoreAws())
+}
+
+// keyValueTagsSageMaker returns the tags engine representation of the tags.
+func keyValueTagsSageMaker(ts []*sagemaker.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
+	}
+
+	return tags
+}
+
+// tagsFromKeyValueTagsSageMaker returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsSageMaker(tags keyvaluetags.KeyValueTags) []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
+
+	return result
+}
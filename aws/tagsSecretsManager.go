@@ -1,75 +1,54 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsSecretsManager takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsSecretsManager(oldTags, newTags []*secretsmanager.Tag) ([]*secretsmanager.Tag, []*secretsmanager.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := keyValueTagsSecretsManager(oldTags), keyValueTagsSecretsManager(newTags)
 
-	// Build the list of what to remove
-	var remove []*secretsmanager.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapSecretsManager(create), remove
+	return tagsFromKeyValueTagsSecretsManager(o.Updated(n)), tagsFromKeyValueTagsSecretsManager(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapSecretsManager returns the tags for the given map of data.
 func tagsFromMapSecretsManager(m map[string]interface{}) []*secretsmanager.Tag {
-	result := make([]*secretsmanager.Tag, 0, len(m))
-	for k, v := range m {
-		t := &secretsmanager.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredSecretsManager(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsSecretsManager(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapSecretsManager turns the list of tags into a map.
 func tagsToMapSecretsManager(ts []*secretsmanager.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsSecretsManager(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredSecretsManager returns whether the tag is reserved and must be ignored.
+func tagIgnoredSecretsManager(t *secretsmanager.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsSecretsManager returns the tags engine representation of the tags.
+func keyValueTagsSecretsManager(ts []*secretsmanager.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredSecretsManager(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredSecretsManager(t *secretsmanager.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsSecretsManager returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsSecretsManager(tags keyvaluetags.KeyValueTags) []*secretsmanager.Tag {
+	result := make([]*secretsmanager.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
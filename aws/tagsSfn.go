@@ -1,78 +1,54 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// diffTags takes our tags locally and the ones remotely and returns
+// diffTagsSfn takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsSfn(oldTags, newTags []*sfn.Tag) ([]*sfn.Tag, []*sfn.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
+	o, n := keyValueTagsSfn(oldTags), keyValueTagsSfn(newTags)
 
-	// Build the list of what to remove
-	var remove []*sfn.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			// Delete it!
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
+	return tagsFromKeyValueTagsSfn(o.Updated(n)), tagsFromKeyValueTagsSfn(o.Removed(n))
+}
 
-	return tagsFromMapSfn(create), remove
+// tagsFromMapSfn returns the tags for the given map of data.
+func tagsFromMapSfn(m map[string]interface{}) []*sfn.Tag {
+	return tagsFromKeyValueTagsSfn(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMapSfn(tagMap map[string]interface{}) []*sfn.Tag {
-	tags := make([]*sfn.Tag, 0, len(tagMap))
-	for tagKey, tagValueRaw := range tagMap {
-		tag := &sfn.Tag{
-			Key:   aws.String(tagKey),
-			Value: aws.String(tagValueRaw.(string)),
-		}
-		if !tagIgnoredSfn(tag) {
-			tags = append(tags, tag)
-		}
-	}
+// tagsToMapSfn turns the list of tags into a map.
+func tagsToMapSfn(ts []*sfn.Tag) map[string]string {
+	return keyValueTagsSfn(ts).IgnoreAws().Map()
+}
 
-	return tags
+// tagIgnoredSfn returns whether the tag is reserved and must be ignored.
+func tagIgnoredSfn(t *sfn.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapSfn(tags []*sfn.Tag) map[string]string {
-	tagMap := make(map[string]string)
-	for _, tag := range tags {
-		if !tagIgnoredSfn(tag) {
-			tagMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
-		}
+// keyValueTagsSfn returns the tags engine representation of the tags.
+func keyValueTagsSfn(ts []*sfn.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return tagMap
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredSfn(t *sfn.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, aws.StringValue(t.Key))
-		r, _ := regexp.MatchString(v, aws.StringValue(t.Key))
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", aws.StringValue(t.Key), aws.StringValue(t.Value))
-			return true
-		}
+// tagsFromKeyValueTagsSfn returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsSfn(tags keyvaluetags.KeyValueTags) []*sfn.Tag {
+	result := make([]*sfn.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &sfn.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
@@ -4,88 +4,92 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-func dmsTagsToMap(tags []*dms.Tag) map[string]string {
-	result := make(map[string]string)
+// dmsSetTags is a helper to set the tags for a resource. It expects the
+// tags field to be named "tags"
+func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
+		return tagServiceDMS(meta.(*AWSClient).dmsconn).Update(arn, o, n)
 	}
 
-	return result
+	return nil
 }
 
-func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
-	result := make([]*dms.Tag, 0, len(m))
+// tagServiceDMS returns the tags engine adapter for DMS resources.
+func tagServiceDMS(conn *dms.DatabaseMigrationService) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "DMS",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
+				ResourceArn: aws.String(identifier),
+				Tags:        tagsFromKeyValueTagsDMS(tags),
+			})
 
-	for k, v := range m {
-		result = append(result, &dms.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
 
-	return result
+			return err
+		},
+	}
 }
 
+// dmsDiffTags takes our tags locally and the ones remotely and returns
+// the set of tags that must be created, and the set of tags that must
+// be destroyed.
 func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := keyValueTagsDMS(oldTags), keyValueTagsDMS(newTags)
 
-	remove := []*dms.Tag{}
-	for _, t := range oldTags {
-		v, ok := create[*t.Key]
-		if !ok || v != *t.Value {
-			remove = append(remove, t)
-		}
-	}
+	return tagsFromKeyValueTagsDMS(o.Updated(n)), tagsFromKeyValueTagsDMS(o.Removed(n))
+}
 
-	return dmsTagsFromMap(create), remove
+// dmsTagsFromMap returns the tags for the given map of data.
+func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
+	return tagsFromKeyValueTagsDMS(keyvaluetags.New(m).IgnoreAws())
 }
 
-func dmsGetTagKeys(tags []*dms.Tag) []*string {
-	keys := []*string{}
+// dmsTagsToMap turns the list of tags into a map.
+func dmsTagsToMap(ts []*dms.Tag) map[string]string {
+	return keyValueTagsDMS(ts).IgnoreAws().Map()
+}
 
-	for _, tag := range tags {
-		keys = append(keys, tag.Key)
+// keyValueTagsDMS returns the tags engine representation of the tags.
+func keyValueTagsDMS(ts []*dms.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return keys
+	return tags
 }
 
-func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+// tagsFromKeyValueTagsDMS returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsDMS(tags keyvaluetags.KeyValueTags) []*dms.Tag {
+	result := make([]*dms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dms.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
+	}
 
-		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
+	return result
+}
 
-		if len(remove) > 0 {
-			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     dmsGetTagKeys(remove),
-			})
-			if err != nil {
-				return err
-			}
-		}
+func dmsGetTagKeys(tags []*dms.Tag) []*string {
+	keys := []*string{}
 
-		if len(add) > 0 {
-			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        add,
-			})
-			if err != nil {
-				return err
-			}
-		}
+	for _, tag := range tags {
+		keys = append(keys, tag.Key)
 	}
 
-	return nil
+	return keys
 }
@@ -1,112 +1,97 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
+// Route 53 allows at most 10 tags to be added or removed per request
+const route53TagBatchLimit = 10
+
+// setTagsR53 is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsR53(tagsFromMapR53(o), tagsFromMapR53(n))
-
-		// Set tags
-		r := make([]*string, len(remove))
-		for i, t := range remove {
-			r[i] = t.Key
-		}
-		log.Printf("[DEBUG] Changing tags: \n\tadding: %#v\n\tremoving:%#v", create, remove)
-		req := &route53.ChangeTagsForResourceInput{
-			ResourceId:   aws.String(d.Id()),
-			ResourceType: aws.String(resourceType),
-		}
-
-		if len(create) > 0 {
-			req.AddTags = create
-		}
-		if len(r) > 0 {
-			req.RemoveTagKeys = r
-		}
-
-		_, err := conn.ChangeTagsForResource(req)
-		if err != nil {
-			return err
-		}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		return tagServiceR53(conn, resourceType).Update(d.Id(), o, n)
 	}
 
 	return nil
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
+// tagServiceR53 returns the tags engine adapter for Route 53 resources.
+func tagServiceR53(conn *route53.Route53, resourceType string) *keyvaluetags.Service {
+	return &keyvaluetags.Service{
+		Name: "Route 53",
+		TagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+				ResourceId:   aws.String(identifier),
+				ResourceType: aws.String(resourceType),
+				AddTags:      tagsFromKeyValueTagsR53(tags),
+			})
+
+			return err
+		},
+		UntagResource: func(identifier string, tags keyvaluetags.KeyValueTags) error {
+			_, err := conn.ChangeTagsForResource(&route53.ChangeTagsForResourceInput{
+				ResourceId:    aws.String(identifier),
+				ResourceType:  aws.String(resourceType),
+				RemoveTagKeys: aws.StringSlice(tags.Keys()),
+			})
+
+			return err
+		},
+		TagBatchSize:   route53TagBatchLimit,
+		UntagBatchSize: route53TagBatchLimit,
+	}
+}
+
+// diffTagsR53 takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsR53(oldTags, newTags []*route53.Tag) ([]*route53.Tag, []*route53.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*route53.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
+	o, n := keyValueTagsR53(oldTags), keyValueTagsR53(newTags)
 
-	return tagsFromMapR53(create), remove
+	return tagsFromKeyValueTagsR53(o.Updated(n)), tagsFromKeyValueTagsR53(o.Removed(n))
 }
 
-// tagsFromMap returns the tags for the given map of data.
+// tagsFromMapR53 returns the tags for the given map of data.
 func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
-	result := make([]*route53.Tag, 0, len(m))
-	for k, v := range m {
-		t := &route53.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredRoute53(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return tagsFromKeyValueTagsR53(keyvaluetags.New(m).IgnoreAws())
 }
 
-// tagsToMap turns the list of tags into a map.
+// tagsToMapR53 turns the list of tags into a map.
 func tagsToMapR53(ts []*route53.Tag) map[string]string {
-	result := make(map[string]string)
+	return keyValueTagsR53(ts).IgnoreAws().Map()
+}
+
+// tagIgnoredRoute53 returns whether the tag is reserved and must be ignored.
+func tagIgnoredRoute53(t *route53.Tag) bool {
+	return keyvaluetags.IsAwsKey(aws.StringValue(t.Key))
+}
+
+// keyValueTagsR53 returns the tags engine representation of the tags.
+func keyValueTagsR53(ts []*route53.Tag) keyvaluetags.KeyValueTags {
+	tags := make(keyvaluetags.KeyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredRoute53(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = t.Value
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredRoute53(t *route53.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// tagsFromKeyValueTagsR53 returns the list of tags, ordered by key.
+func tagsFromKeyValueTagsR53(tags keyvaluetags.KeyValueTags) []*route53.Tag {
+	result := make([]*route53.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &route53.Tag{
+			Key:   aws.String(k),
+			Value: tags[k],
+		})
 	}
-	return false
+
+	return result
 }
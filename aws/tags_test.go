@@ -7,8 +7,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestDiffTags(t *testing.T) {
@@ -42,9 +45,7 @@ func TestDiffTags(t *testing.T) {
 			Create: map[string]string{
 				"foo": "baz",
 			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
+			Remove: map[string]string{},
 		},
 
 		// Overlap
@@ -60,9 +61,7 @@ func TestDiffTags(t *testing.T) {
 			Create: map[string]string{
 				"foo": "baz",
 			},
-			Remove: map[string]string{
-				"foo": "bar",
-			},
+			Remove: map[string]string{},
 		},
 
 		// Remove
@@ -178,3 +177,333 @@ func testAccCheckTags(
 		return nil
 	}
 }
+
+func TestTagsMergeDefaults(t *testing.T) {
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Owner": "platform",
+			"Env":   "test",
+		},
+	}
+
+	cases := []struct {
+		Meta     interface{}
+		Tags     map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		// No default tags
+		{
+			Meta: &AWSClient{},
+			Tags: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// No resource tags
+		{
+			Meta: meta,
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Owner": "platform",
+				"Env":   "test",
+			},
+		},
+
+		// Resource tags take precedence
+		{
+			Meta: meta,
+			Tags: map[string]interface{}{
+				"Name": "foo",
+				"Env":  "prod",
+			},
+			Expected: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "platform",
+				"Env":   "prod",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := tagsMergeDefaults(tc.Meta, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestTagsWithoutDefaults(t *testing.T) {
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Owner": "platform",
+			"Env":   "test",
+		},
+	}
+
+	cases := []struct {
+		Tags, Configured map[string]interface{}
+		Expected         map[string]interface{}
+	}{
+		// Inherited default tags
+		{
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "platform",
+				"Env":   "test",
+			},
+			Configured: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// Default tag also set on the resource
+		{
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "platform",
+				"Env":   "test",
+			},
+			Configured: map[string]interface{}{
+				"Name": "foo",
+				"Env":  "test",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+				"Env":  "test",
+			},
+		},
+
+		// Default tag changed outside of Terraform
+		{
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "someone-else",
+				"Env":   "test",
+			},
+			Configured: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "someone-else",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := tagsWithoutDefaults(meta, tc.Tags, tc.Configured)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestTagsWithoutIgnored(t *testing.T) {
+	meta := &AWSClient{
+		ignoreTagsKeys:        []string{"LastScanned"},
+		ignoreTagsKeyPrefixes: []string{"kubernetes.io/cluster/", "scanner:"},
+	}
+
+	tags := map[string]interface{}{
+		"Name":                        "foo",
+		"LastScanned":                 "2019-01-01",
+		"LastScannedBy":               "bar",
+		"kubernetes.io/cluster/test":  "owned",
+		"scanner:result":              "clean",
+		"kubernetes.io/role/internal": "1",
+	}
+
+	expected := map[string]interface{}{
+		"Name":                        "foo",
+		"LastScannedBy":               "bar",
+		"kubernetes.io/role/internal": "1",
+	}
+
+	actual := tagsWithoutIgnored(meta, tags)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	if actual := tagsWithoutIgnored(&AWSClient{}, tags); !reflect.DeepEqual(actual, tags) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestTagsMergeIgnored(t *testing.T) {
+	meta := &AWSClient{
+		ignoreTagsKeys:        []string{"LastScanned"},
+		ignoreTagsKeyPrefixes: []string{"scanner:"},
+	}
+
+	remoteTags := keyvaluetags.New(map[string]string{
+		"Name":           "old",
+		"Removed":        "bar",
+		"LastScanned":    "2019-01-01",
+		"scanner:result": "clean",
+		"aws:reserved":   "baz",
+	})
+
+	tags := map[string]interface{}{
+		"Name":  "new",
+		"Added": "foo",
+	}
+
+	expected := map[string]string{
+		"Name":           "new",
+		"Added":          "foo",
+		"LastScanned":    "2019-01-01",
+		"scanner:result": "clean",
+	}
+
+	if actual := tagsMergeIgnored(meta, tags, remoteTags).Map(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+
+	expected = map[string]string{
+		"Name":  "new",
+		"Added": "foo",
+	}
+
+	if actual := tagsMergeIgnored(&AWSClient{}, tags, remoteTags).Map(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestResourceWithProviderTags(t *testing.T) {
+	remote := make(map[string]string)
+
+	update := func(d *schema.ResourceData, meta interface{}) error {
+		_, n := d.GetChange("tags_all")
+		remote = make(map[string]string)
+		for k, v := range n.(map[string]interface{}) {
+			remote[k] = v.(string)
+		}
+		return nil
+	}
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return d.Set("tags", remote)
+	}
+
+	r := resourceWithProviderTags(&schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("foo")
+			if err := update(d, meta); err != nil {
+				return err
+			}
+			return read(d, meta)
+		},
+		Read: read,
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			if err := update(d, meta); err != nil {
+				return err
+			}
+			return read(d, meta)
+		},
+		Delete: schema.Noop,
+
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	})
+
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Owner": "platform",
+			"Env":   "test",
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name": "foo",
+			"Env":  "prod",
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	c := terraform.NewResourceConfig(raw)
+
+	diff, err := r.Diff(nil, c, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state, err := r.Apply(nil, diff, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expectedRemote := map[string]string{
+		"Name":  "foo",
+		"Owner": "platform",
+		"Env":   "prod",
+	}
+	if !reflect.DeepEqual(remote, expectedRemote) {
+		t.Fatalf("bad remote tags: %#v", remote)
+	}
+
+	state, err = r.Refresh(state, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v := state.Attributes["tags.%"]; v != "2" {
+		t.Fatalf("bad tags count: %s", v)
+	}
+	if v := state.Attributes["tags_all.%"]; v != "3" {
+		t.Fatalf("bad tags_all count: %s", v)
+	}
+
+	diff, err = r.Diff(state, c, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("unexpected diff: %#v", diff)
+	}
+
+	// Changing the default tags must update the resource
+	meta.defaultTags["Owner"] = "data"
+
+	diff, err = r.Diff(state, c, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff.Empty() {
+		t.Fatal("expected diff for changed default tags")
+	}
+
+	if _, err := r.Apply(state, diff, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := remote["Owner"]; v != "data" {
+		t.Fatalf("bad remote Owner tag: %s", v)
+	}
+
+	// Ignored tags added outside of Terraform must not show up as a diff
+	meta.ignoreTagsKeyPrefixes = []string{"scanner:"}
+	remote["scanner:result"] = "clean"
+
+	state, err = r.Refresh(state, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := state.Attributes["tags_all.scanner:result"]; ok {
+		t.Fatal("ignored tag read into tags_all")
+	}
+
+	diff, err = r.Diff(state, c, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("unexpected diff: %#v", diff)
+	}
+}
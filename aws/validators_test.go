@@ -1029,6 +1029,113 @@ func TestValidateOnceAWeekWindowFormat(t *testing.T) {
 	}
 }
 
+func TestValidateSagemakerName(t *testing.T) {
+	validNames := []string{
+		"ValidSageMakerName",
+		"Valid-5a63Mak3r-Name",
+		"123-456-789",
+		"1234",
+		strings.Repeat("W", 63),
+	}
+	for _, v := range validNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"Invalid name",
+		"1#{}nook",
+		"-invalid",
+		"invalid_name",
+		strings.Repeat("W", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name", v)
+		}
+	}
+}
+
+func TestValidateFsxWeeklyMaintenanceStartTime(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			// invalid day of week
+			Value:    "8:04:00",
+			ErrCount: 1,
+		},
+		{
+			// invalid hour
+			Value:    "1:24:00",
+			ErrCount: 1,
+		},
+		{
+			// invalid min
+			Value:    "1:04:60",
+			ErrCount: 1,
+		},
+		{
+			// missing day of week
+			Value:    "04:00",
+			ErrCount: 1,
+		},
+		{
+			// valid format
+			Value:    "7:23:59",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateFsxWeeklyMaintenanceStartTime(tc.Value, "weekly_maintenance_start_time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestValidateFsxDailyAutomaticBackupStartTime(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			// invalid hour
+			Value:    "24:00",
+			ErrCount: 1,
+		},
+		{
+			// invalid min
+			Value:    "04:60",
+			ErrCount: 1,
+		},
+		{
+			// weekly format
+			Value:    "1:04:00",
+			ErrCount: 1,
+		},
+		{
+			// valid format
+			Value:    "00:00",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateFsxDailyAutomaticBackupStartTime(tc.Value, "daily_automatic_backup_start_time")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func TestValidateOnceADayWindowFormat(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -1707,6 +1814,33 @@ func TestValidateNeptuneSubnetGroupName(t *testing.T) {
 	}
 }
 
+func TestValidateDocDBIdentifier(t *testing.T) {
+	validNames := []string{
+		"tf-acc-test",
+		"docdb-cluster-1",
+	}
+	for _, v := range validNames {
+		_, errors := validateDocDBIdentifier(v, "cluster_identifier")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DocDB identifier: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"1docdb",
+		"DocDB",
+		"docdb--cluster",
+		"docdb-cluster-",
+		"docdb_cluster",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateDocDBIdentifier(v, "cluster_identifier")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DocDB identifier", v)
+		}
+	}
+}
+
 func TestValidateDbSubnetGroupNamePrefix(t *testing.T) {
 	cases := []struct {
 		Value    string